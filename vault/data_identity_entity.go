@@ -197,7 +197,7 @@ func identityEntityLookup(client *api.Client, data map[string]interface{}) (*api
 	resp, err := client.Logical().Write("identity/lookup/entity", data)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error reading Identity Entity: %s", err)
+		return nil, fmt.Errorf("error reading Identity Entity: %s", err)
 	}
 
 	if resp == nil {
@@ -172,7 +172,7 @@ func identityGroupLookup(client *api.Client, data map[string]interface{}) (*api.
 	resp, err := client.Logical().Write("identity/lookup/group", data)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error reading Identity Group: %s", err)
+		return nil, fmt.Errorf("error reading Identity Group: %s", err)
 	}
 
 	if resp == nil {
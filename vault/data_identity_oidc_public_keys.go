@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcPublicKeysPath = "/v1/identity/oidc/.well-known/keys"
+
+func identityOidcPublicKeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityOidcPublicKeysDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public portion of keys used to sign OIDC tokens, as a JSON Web Key Set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func identityOidcPublicKeysDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// the well-known endpoint returns a bare JSON Web Key Set rather than a
+	// standard Vault response, so it has to be requested directly.
+	req := client.NewRequest("GET", identityOidcPublicKeysPath)
+
+	log.Printf("[DEBUG] Reading IdentityOidcPublicKeys from Vault")
+	resp, err := client.RawRequest(req)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcPublicKeys from Vault: %s", err)
+	}
+	defer resp.Body.Close()
+	log.Printf("[DEBUG] Read IdentityOidcPublicKeys from Vault")
+
+	var out struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return fmt.Errorf("error decoding IdentityOidcPublicKeys response: %s", err)
+	}
+
+	keys := make([]map[string]string, 0, len(out.Keys))
+	for _, key := range out.Keys {
+		flattened := make(map[string]string, len(key))
+		for k, v := range key {
+			flattened[k] = fmt.Sprintf("%v", v)
+		}
+		keys = append(keys, flattened)
+	}
+
+	d.SetId(client.Address() + identityOidcPublicKeysPath)
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys for IdentityOidcPublicKeys: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceIdentityOidcPublicKeys_basic(t *testing.T) {
+	key := acctest.RandomWithPrefix("tf-test-key")
+	role := acctest.RandomWithPrefix("tf-test-role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIdentityOidcPublicKeysConfig_basic(key, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_identity_oidc_public_keys.test", "keys.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIdentityOidcPublicKeysConfig_basic(key, role string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name = "%s"
+  algorithm = "RS256"
+}
+
+resource "vault_identity_oidc_role" "role" {
+  name = "%s"
+  key = "${vault_identity_oidc_key.key.name}"
+}
+
+data "vault_identity_oidc_public_keys" "test" {
+  depends_on = ["vault_identity_oidc_role.role"]
+}
+`, key, role)
+}
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcTokenPathTemplate = "identity/oidc/token/%s"
+
+func identityOidcTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityOidcTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the OIDC role to generate a token against.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The signed OIDC token.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client ID of the role, used as the aud claim of the token.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TTL of the token in seconds.",
+			},
+		},
+	}
+}
+
+func identityOidcTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	role := d.Get("role").(string)
+	path := fmt.Sprintf(identityOidcTokenPathTemplate, role)
+
+	log.Printf("[DEBUG] Generating IdentityOidcToken for role %q", role)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating IdentityOidcToken for role %q: %s", role, err)
+	}
+	log.Printf("[DEBUG] Generated IdentityOidcToken for role %q", role)
+	if resp == nil {
+		return fmt.Errorf("no role found at %q", path)
+	}
+
+	d.SetId(role)
+	d.Set("token", resp.Data["token"])
+	d.Set("client_id", resp.Data["client_id"])
+	d.Set("ttl", resp.Data["ttl"])
+
+	return nil
+}
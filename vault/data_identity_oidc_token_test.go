@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceIdentityOidcToken_basic(t *testing.T) {
+	key := acctest.RandomWithPrefix("tf-test-key")
+	role := acctest.RandomWithPrefix("tf-test-role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIdentityOidcTokenConfig_basic(key, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_identity_oidc_token.test", "role", role),
+					resource.TestCheckResourceAttrSet("data.vault_identity_oidc_token.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_identity_oidc_token.test", "client_id"),
+					resource.TestCheckResourceAttrSet("data.vault_identity_oidc_token.test", "ttl"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIdentityOidcTokenConfig_basic(key, role string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name = "%s"
+  algorithm = "RS256"
+}
+
+resource "vault_identity_oidc_role" "role" {
+  name = "%s"
+  key = "${vault_identity_oidc_key.key.name}"
+}
+
+resource "vault_identity_oidc_key_allowed_client_id" "allowed" {
+  key_name = "${vault_identity_oidc_key.key.name}"
+  allowed_client_id = "${vault_identity_oidc_role.role.client_id}"
+}
+
+data "vault_identity_oidc_token" "test" {
+  role = "${vault_identity_oidc_role.role.name}"
+
+  depends_on = ["vault_identity_oidc_key_allowed_client_id.allowed"]
+}
+`, key, role)
+}
@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const activityCountersPath = "sys/internal/counters/activity"
+
+func activityCountersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: activityCountersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start of the period to report on, in RFC3339 format. Defaults to the configured default reporting period.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the period to report on, in RFC3339 format.",
+			},
+			"total_distinct_entities": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of distinct entities that created a token during the period.",
+			},
+			"total_non_entity_tokens": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of active tokens without an entity attached during the period.",
+			},
+			"total_clients": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of clients during the period.",
+			},
+			"by_namespace": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The client counts broken down by namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"distinct_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"non_entity_tokens": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"clients": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func activityCountersDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	params := map[string][]string{}
+	if v, ok := d.GetOk("start_time"); ok {
+		params["start_time"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		params["end_time"] = []string{v.(string)}
+	}
+
+	log.Printf("[DEBUG] Reading activity counters %q", activityCountersPath)
+	resp, err := client.Logical().ReadWithData(activityCountersPath, params)
+	if err != nil {
+		return fmt.Errorf("error reading activity counters: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no activity counters found at %q; no data may have been collected yet", activityCountersPath)
+	}
+	log.Printf("[DEBUG] Read activity counters %q", activityCountersPath)
+
+	d.SetId(client.Address() + "/" + activityCountersPath)
+
+	if v, ok := resp.Data["start_time"]; ok && v != nil {
+		d.Set("start_time", fmt.Sprintf("%v", v))
+	}
+	if v, ok := resp.Data["end_time"]; ok && v != nil {
+		d.Set("end_time", fmt.Sprintf("%v", v))
+	}
+
+	if total, ok := resp.Data["total"].(map[string]interface{}); ok {
+		d.Set("total_distinct_entities", activityCountersCount(total, "distinct_entities"))
+		d.Set("total_non_entity_tokens", activityCountersCount(total, "non_entity_tokens"))
+		d.Set("total_clients", activityCountersCount(total, "clients"))
+	}
+
+	byNamespace := []map[string]interface{}{}
+	if namespaces, ok := resp.Data["by_namespace"].([]interface{}); ok {
+		for _, namespaceI := range namespaces {
+			namespace, ok := namespaceI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			counts, _ := namespace["counts"].(map[string]interface{})
+			byNamespace = append(byNamespace, map[string]interface{}{
+				"namespace_id":      namespace["namespace_id"],
+				"namespace_path":    namespace["namespace_path"],
+				"distinct_entities": activityCountersCount(counts, "distinct_entities"),
+				"non_entity_tokens": activityCountersCount(counts, "non_entity_tokens"),
+				"clients":           activityCountersCount(counts, "clients"),
+			})
+		}
+	}
+	if err := d.Set("by_namespace", byNamespace); err != nil {
+		return fmt.Errorf("error setting by_namespace: %s", err)
+	}
+
+	return nil
+}
+
+func activityCountersCount(counts map[string]interface{}, field string) int64 {
+	if v, ok := counts[field].(json.Number); ok {
+		if count, err := v.Int64(); err == nil {
+			return count
+		}
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceActivityCounters_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceActivityCountersConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_activity_counters.test", "total_clients"),
+				),
+			},
+		},
+	})
+}
+
+// Activity reporting is off by default, so the test enables it before
+// querying the counters.
+const testAccDataSourceActivityCountersConfig_basic = `
+resource "vault_generic_endpoint" "counters_config" {
+  path = "sys/internal/counters/config"
+  disable_read = true
+  disable_delete = true
+  data_json = <<EOT
+{
+  "enabled": "enable"
+}
+EOT
+}
+
+data "vault_activity_counters" "test" {
+  depends_on = ["vault_generic_endpoint.counters_config"]
+}
+`
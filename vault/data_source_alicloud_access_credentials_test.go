@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAliCloudAccessCredentials_basic(t *testing.T) {
+	accessKey, secretKey := getTestAliCloudCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAliCloudAccessCredentialsConfig_basic(backend, accessKey, secretKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_alicloud_access_credentials.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_alicloud_access_credentials.test", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_alicloud_access_credentials.test", "access_key"),
+					resource.TestCheckResourceAttrSet("data.vault_alicloud_access_credentials.test", "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAliCloudAccessCredentialsConfig_basic(backend, accessKey, secretKey, name string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend = "${vault_alicloud_secret_backend.test.path}"
+  name = "%s"
+  remote_policies = ["name:AliyunOSSReadOnlyAccess,type:System"]
+}
+
+data "vault_alicloud_access_credentials" "test" {
+  backend = "${vault_alicloud_secret_backend.test.path}"
+  role = "${vault_alicloud_secret_backend_role.test.name}"
+}
+`, backend, accessKey, secretKey, name)
+}
@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func authBackendsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: authBackendsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the auth method type. If set, only backends of this type are returned.",
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The auth backend mount points, sorted alphabetically.",
+			},
+			"accessors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The accessors of the auth backends, in the same order as paths.",
+			},
+		},
+	}
+}
+
+func authBackendsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	targetType := d.Get("type").(string)
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	paths := make([]string, 0, len(auths))
+	for path, auth := range auths {
+		if targetType != "" && auth.Type != targetType {
+			continue
+		}
+		paths = append(paths, strings.TrimSuffix(path, "/"))
+	}
+	// The API returns auth methods keyed by path; sort so the lists are
+	// stable across reads.
+	sort.Strings(paths)
+
+	accessors := make([]string, 0, len(paths))
+	for _, path := range paths {
+		accessors = append(accessors, auths[path+"/"].Accessor)
+	}
+
+	if err := d.Set("paths", paths); err != nil {
+		return fmt.Errorf("error setting paths: %s", err)
+	}
+	if err := d.Set("accessors", accessors); err != nil {
+		return fmt.Errorf("error setting accessors: %s", err)
+	}
+
+	// There is no canonical identifier for the full set of auth backends, so
+	// scope the ID to the server being queried and any filter applied.
+	if targetType != "" {
+		d.SetId(client.Address() + "/sys/auth/" + targetType)
+	} else {
+		d.SetId(client.Address() + "/sys/auth")
+	}
+
+	return nil
+}
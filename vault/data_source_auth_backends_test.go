@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAuthBackends_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-auth")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAuthBackendsConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_auth_backends.test", "paths.#"),
+					resource.TestCheckResourceAttrSet("data.vault_auth_backends.test", "accessors.#"),
+				),
+			},
+			{
+				Config: testAccDataSourceAuthBackendsConfig_filtered(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_auth_backends.test", "type", "userpass"),
+					resource.TestCheckResourceAttr("data.vault_auth_backends.test", "paths.0", path),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAuthBackendsConfig_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s"
+}
+
+data "vault_auth_backends" "test" {
+  depends_on = ["vault_auth_backend.test"]
+}
+`, path)
+}
+
+func testAccDataSourceAuthBackendsConfig_filtered(path string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s"
+}
+
+data "vault_auth_backends" "test" {
+  type = "userpass"
+
+  depends_on = ["vault_auth_backend.test"]
+}
+`, path)
+}
@@ -69,6 +69,17 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 				Optional:    true,
 				Description: "ARN to use if multiple are available in the role. Required if the role has multiple ARNs.",
 			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Requested TTL for the credentials. Only valid when type is 'sts', or for roles of type assumed_role or federation_token.",
+			},
+			"mfa_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The TOTP code for the MFA device associated with the role, if any.",
+			},
 			"access_key": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -127,6 +138,12 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	data := map[string][]string{
 		"role_arn": {arn},
 	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("mfa_code"); ok {
+		data["mfa_code"] = []string{v.(string)}
+	}
 
 	log.Printf("[DEBUG] Reading %q from Vault with data %#v", path, data)
 	secret, err := client.Logical().ReadWithData(path, data)
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAWSStaticAccessCredentials_basic(t *testing.T) {
+	accessKey, secretKey := getTestAWSCreds(t)
+	username := os.Getenv("AWS_STATIC_USER")
+	if username == "" {
+		t.Skip("AWS_STATIC_USER not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSStaticAccessCredentialsConfig_basic(backend, accessKey, secretKey, name, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_aws_static_access_credentials.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_aws_static_access_credentials.test", "name", name),
+					resource.TestCheckResourceAttrSet("data.vault_aws_static_access_credentials.test", "access_key"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_static_access_credentials.test", "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSStaticAccessCredentialsConfig_basic(backend, accessKey, secretKey, name, username string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_static_role" "test" {
+  backend = "${vault_aws_secret_backend.test.path}"
+  name = "%s"
+  username = "%s"
+  rotation_period = 3600
+}
+
+data "vault_aws_static_access_credentials" "test" {
+  backend = "${vault_aws_secret_backend.test.path}"
+  name = "${vault_aws_secret_backend_static_role.test.name}"
+}
+`, backend, accessKey, secretKey, name, username)
+}
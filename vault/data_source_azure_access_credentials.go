@@ -53,6 +53,21 @@ func azureAccessCredentialsDataSource() *schema.Resource {
 				Default:     20 * 60, // 20 minutes
 				Description: `If 'validate_creds' is true, the number of seconds after which to give up validating credentials.`,
 			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subscription ID to use during credential validation. Defaults to the subscription ID configured in the Vault backend.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The tenant ID to use during credential validation. Defaults to the tenant ID configured in the Vault backend.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Azure environment to use during credential validation. Defaults to the environment configured in the Vault backend. Avoids altering the Vault backend configuration.",
+			},
 			"client_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -125,31 +140,42 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 		return nil
 	}
 
-	secret, err = client.Logical().Read(configPath)
-	if err != nil {
-		return fmt.Errorf("error reading from Vault: %s", err)
-	}
-	log.Printf("[DEBUG] Read %q from Vault", configPath)
+	subscriptionID := d.Get("subscription_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+	environment := d.Get("environment").(string)
 
-	subscriptionID := ""
-	if subscriptionIDIfc, ok := secret.Data["subscription_id"]; ok {
-		subscriptionID = subscriptionIDIfc.(string)
-	}
-	if subscriptionID == "" {
-		return fmt.Errorf(`unable to parse 'subscription_id' from %s`, configPath)
-	}
+	// any of the values not supplied directly are looked up from the backend
+	// config, which requires read access on the config endpoint
+	if subscriptionID == "" || tenantID == "" || environment == "" {
+		secret, err = client.Logical().Read(configPath)
+		if err != nil {
+			return fmt.Errorf("error reading from Vault: %s", err)
+		}
+		log.Printf("[DEBUG] Read %q from Vault", configPath)
+
+		if subscriptionID == "" {
+			if subscriptionIDIfc, ok := secret.Data["subscription_id"]; ok {
+				subscriptionID = subscriptionIDIfc.(string)
+			}
+			if subscriptionID == "" {
+				return fmt.Errorf(`unable to parse 'subscription_id' from %s`, configPath)
+			}
+		}
 
-	tenantID := ""
-	if tenantIDIfc, ok := secret.Data["tenant_id"]; ok {
-		tenantID = tenantIDIfc.(string)
-	}
-	if tenantID == "" {
-		return fmt.Errorf(`unable to parse 'tenant_id' from %s`, configPath)
-	}
+		if tenantID == "" {
+			if tenantIDIfc, ok := secret.Data["tenant_id"]; ok {
+				tenantID = tenantIDIfc.(string)
+			}
+			if tenantID == "" {
+				return fmt.Errorf(`unable to parse 'tenant_id' from %s`, configPath)
+			}
+		}
 
-	environment := ""
-	if environmentIfc, ok := secret.Data["environment"]; ok {
-		environment = environmentIfc.(string)
+		if environment == "" {
+			if environmentIfc, ok := secret.Data["environment"]; ok {
+				environment = environmentIfc.(string)
+			}
+		}
 	}
 
 	// Let's, test the credentials before returning them.
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func capabilitiesSelfDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: capabilitiesSelfDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path on which to check the token's capabilities.",
+			},
+			"capabilities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The capabilities granted to the token on the path.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func capabilitiesSelfDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Reading capabilities on %q from Vault", path)
+	capabilities, err := client.Sys().CapabilitiesSelf(path)
+	if err != nil {
+		return fmt.Errorf("error reading capabilities on %q from Vault: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read capabilities on %q from Vault", path)
+
+	d.SetId(client.Address() + "/sys/capabilities-self/" + path)
+
+	d.Set("capabilities", capabilities)
+
+	return nil
+}
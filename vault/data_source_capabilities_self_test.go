@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceCapabilitiesSelf_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCapabilitiesSelfConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_capabilities_self.test", "path", "secret/data/app"),
+					resource.TestCheckResourceAttrSet("data.vault_capabilities_self.test", "capabilities.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceCapabilitiesSelfConfig_basic = `
+data "vault_capabilities_self" "test" {
+  path = "secret/data/app"
+}
+`
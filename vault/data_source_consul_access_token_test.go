@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceConsulAccessToken_basic(t *testing.T) {
+	address := os.Getenv("CONSUL_HTTP_ADDR")
+	token := os.Getenv("CONSUL_HTTP_TOKEN")
+	if address == "" {
+		t.Skip("CONSUL_HTTP_ADDR not set")
+	}
+	if token == "" {
+		t.Skip("CONSUL_HTTP_TOKEN not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-consul")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConsulAccessTokenConfig_basic(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_consul_access_token.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_consul_access_token.test", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_consul_access_token.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_consul_access_token.test", "accessor"),
+					resource.TestCheckResourceAttrSet("data.vault_consul_access_token.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConsulAccessTokenConfig_basic(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  address = "%s"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = "${vault_consul_secret_backend.test.path}"
+  name = "%s"
+  policies = ["global-management"]
+}
+
+data "vault_consul_access_token" "test" {
+  backend = "${vault_consul_secret_backend.test.path}"
+  role = "${vault_consul_secret_backend_role.test.name}"
+}
+`, backend, address, token, name)
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceDatabaseSecretBackendCreds_basic(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	dbName := acctest.RandomWithPrefix("db")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDatabaseSecretBackendCredsConfig_basic(name, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_database_secret_backend_creds.creds", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_database_secret_backend_creds.creds", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_database_secret_backend_creds.creds", "username"),
+					resource.TestCheckResourceAttrSet("data.vault_database_secret_backend_creds.creds", "password"),
+					resource.TestCheckResourceAttrSet("data.vault_database_secret_backend_creds.creds", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabaseSecretBackendCredsConfig_basic(name, db, path, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend = "${vault_mount.db.path}"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  name = "%s"
+  default_ttl = 3600
+  max_ttl = 7200
+  creation_statements = ["CREATE USER '{{name}}'@'%%' IDENTIFIED BY '{{password}}';"]
+}
+
+data "vault_database_secret_backend_creds" "creds" {
+  backend = "${vault_mount.db.path}"
+  role    = "${vault_database_secret_backend_role.test.name}"
+}
+`, path, db, connURL, name)
+}
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretAccessTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpSecretAccessTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"roleset": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the roleset to generate the access token from.",
+				ConflictsWith: []string{"static_account", "impersonated_account"},
+			},
+			"static_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the static account to generate the access token from.",
+				ConflictsWith: []string{"roleset", "impersonated_account"},
+			},
+			"impersonated_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the impersonated account to generate the access token from.",
+				ConflictsWith: []string{"roleset", "static_account"},
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth2 access token.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The remaining lifetime of the access token in seconds.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time at which the access token expires, in seconds since the epoch.",
+			},
+		},
+	}
+}
+
+func gcpSecretAccessTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	var path string
+	if v, ok := d.GetOk("roleset"); ok {
+		path = strings.Trim(backend, "/") + "/roleset/" + v.(string) + "/token"
+	} else if v, ok := d.GetOk("static_account"); ok {
+		path = strings.Trim(backend, "/") + "/static-account/" + v.(string) + "/token"
+	} else if v, ok := d.GetOk("impersonated_account"); ok {
+		path = strings.Trim(backend, "/") + "/impersonated-account/" + v.(string) + "/token"
+	} else {
+		return fmt.Errorf("one of roleset, static_account or impersonated_account must be set")
+	}
+
+	log.Printf("[DEBUG] Generating GCP access token from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating GCP access token from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated GCP access token from %q", path)
+	if secret == nil {
+		return fmt.Errorf("no access token found at %q", path)
+	}
+
+	tokenTTL, err := secret.Data["token_ttl"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("error parsing token_ttl for GCP access token: %s", err)
+	}
+	expiresAt, err := secret.Data["expires_at_seconds"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("error parsing expires_at_seconds for GCP access token: %s", err)
+	}
+
+	// the token itself is sensitive, so it must not be used as the ID
+	d.SetId(path)
+	d.Set("token", secret.Data["token"])
+	d.Set("token_ttl", tokenTTL)
+	d.Set("expires_at_seconds", expiresAt)
+
+	return nil
+}
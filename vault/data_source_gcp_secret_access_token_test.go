@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceGCPSecretAccessToken_static(t *testing.T) {
+	credentials, _ := getTestGCPCreds(t)
+	serviceAccount := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if serviceAccount == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("static")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGCPSecretAccessTokenConfig_static(backend, credentials, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_access_token.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_access_token.test", "static_account", name),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_access_token.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGCPSecretAccessTokenConfig_static(backend, credentials, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "%s"
+  secret_type = "access_token"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+  service_account_email = "%s"
+}
+
+data "vault_gcp_secret_access_token" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "${vault_gcp_secret_static_account.test.static_account}"
+}
+`, backend, credentials, name, serviceAccount)
+}
@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretServiceAccountKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpSecretServiceAccountKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"roleset": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the roleset to generate the service account key from.",
+				ConflictsWith: []string{"static_account"},
+			},
+			"static_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the static account to generate the service account key from.",
+				ConflictsWith: []string{"roleset"},
+			},
+			"key_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The algorithm used to generate the key.",
+			},
+			"key_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The private key type to generate.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The requested TTL for the key lease.",
+			},
+			"private_key_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded private key data of the service account key.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func gcpSecretServiceAccountKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	var path string
+	if v, ok := d.GetOk("roleset"); ok {
+		path = strings.Trim(backend, "/") + "/roleset/" + v.(string) + "/key"
+	} else if v, ok := d.GetOk("static_account"); ok {
+		path = strings.Trim(backend, "/") + "/static-account/" + v.(string) + "/key"
+	} else {
+		return fmt.Errorf("one of roleset or static_account must be set")
+	}
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("key_algorithm"); ok {
+		data["key_algorithm"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_type"); ok {
+		data["key_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Generating GCP service account key from %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating GCP service account key from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated GCP service account key from %q", path)
+	if secret == nil {
+		return fmt.Errorf("no service account key found at %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("private_key_data", secret.Data["private_key_data"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
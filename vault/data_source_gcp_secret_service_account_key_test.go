@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceGCPSecretServiceAccountKey_static(t *testing.T) {
+	credentials, _ := getTestGCPCreds(t)
+	serviceAccount := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if serviceAccount == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("static")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGCPSecretServiceAccountKeyConfig_static(backend, credentials, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_service_account_key.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_service_account_key.test", "static_account", name),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_service_account_key.test", "private_key_data"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_service_account_key.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGCPSecretServiceAccountKeyConfig_static(backend, credentials, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "%s"
+  secret_type = "service_account_key"
+  service_account_email = "%s"
+}
+
+data "vault_gcp_secret_service_account_key" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "${vault_gcp_secret_static_account.test.static_account}"
+}
+`, backend, credentials, name, serviceAccount)
+}
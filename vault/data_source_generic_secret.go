@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -29,6 +31,20 @@ func genericSecretDataSource() *schema.Resource {
 				Default:  latestSecretVersion,
 			},
 
+			"redact_values": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Store salted hashes of the secret values in data and data_json instead of the values themselves, so state files never contain the plaintext secret.",
+			},
+
+			"redaction_salt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Salt mixed into the hashes stored when redact_values is enabled. Keep it stable to allow drift detection between runs.",
+			},
+
 			"data_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -86,11 +102,6 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(secret.RequestID)
 
-	// Ignoring error because this value came from JSON in the
-	// first place so no reason why it should fail to re-encode.
-	jsonDataBytes, _ := json.Marshal(secret.Data)
-	d.Set("data_json", string(jsonDataBytes))
-
 	// Since our "data" map can only contain string values, we
 	// will take strings from Data and write them in as-is,
 	// and write everything else in as a JSON serialization of
@@ -107,12 +118,33 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 			dataMap[k] = string(vBytes)
 		}
 	}
+
+	if d.Get("redact_values").(bool) {
+		// Only salted hashes of the values reach the state, which is enough
+		// for drift detection when the state file is treated as untrusted.
+		salt := d.Get("redaction_salt").(string)
+		for k, v := range dataMap {
+			dataMap[k] = redactedSecretValue(salt, v)
+		}
+		jsonDataBytes, _ := json.Marshal(dataMap)
+		d.Set("data_json", string(jsonDataBytes))
+	} else {
+		// Ignoring error because this value came from JSON in the
+		// first place so no reason why it should fail to re-encode.
+		jsonDataBytes, _ := json.Marshal(secret.Data)
+		d.Set("data_json", string(jsonDataBytes))
+	}
 	d.Set("data", dataMap)
 
 	d.Set("lease_id", secret.LeaseID)
 	d.Set("lease_duration", secret.LeaseDuration)
-	d.Set("lease_start_time", time.Now().Format("RFC3339"))
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
 	d.Set("lease_renewable", secret.Renewable)
 
 	return nil
 }
+
+func redactedSecretValue(salt, value string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + value))
+	return hex.EncodeToString(sum[:])
+}
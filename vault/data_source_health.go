@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func healthDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: healthDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"initialized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster is initialized.",
+			},
+			"sealed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is sealed.",
+			},
+			"standby": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a standby.",
+			},
+			"performance_standby": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a performance standby.",
+			},
+			"replication_performance_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The performance replication mode of the cluster.",
+			},
+			"replication_dr_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disaster recovery replication mode of the cluster.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vault server version.",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the cluster.",
+			},
+		},
+	}
+}
+
+func healthDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading health from Vault")
+	health, err := client.Sys().Health()
+	if err != nil {
+		return fmt.Errorf("error reading health from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read health from Vault")
+
+	d.SetId(client.Address() + "/sys/health")
+
+	d.Set("initialized", health.Initialized)
+	d.Set("sealed", health.Sealed)
+	d.Set("standby", health.Standby)
+	d.Set("performance_standby", health.PerformanceStandby)
+	d.Set("replication_performance_mode", health.ReplicationPerformanceMode)
+	d.Set("replication_dr_mode", health.ReplicationDRMode)
+	d.Set("version", health.Version)
+	d.Set("cluster_name", health.ClusterName)
+	d.Set("cluster_id", health.ClusterID)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceHealth_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceHealthConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_health.test", "initialized", "true"),
+					resource.TestCheckResourceAttr("data.vault_health.test", "sealed", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_health.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceHealthConfig_basic = `
+data "vault_health" "test" {}
+`
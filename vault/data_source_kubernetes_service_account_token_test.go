@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceKubernetesServiceAccountToken_basic(t *testing.T) {
+	host, jwt := getTestKubernetesCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKubernetesServiceAccountTokenConfig_basic(backend, host, jwt, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kubernetes_service_account_token.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_kubernetes_service_account_token.test", "role", name),
+					resource.TestCheckResourceAttr("data.vault_kubernetes_service_account_token.test", "kubernetes_namespace", "default"),
+					resource.TestCheckResourceAttrSet("data.vault_kubernetes_service_account_token.test", "service_account_token"),
+					resource.TestCheckResourceAttrSet("data.vault_kubernetes_service_account_token.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceKubernetesServiceAccountTokenConfig_basic(backend, host, jwt, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path = "%s"
+  kubernetes_host = "%s"
+  service_account_jwt = "%s"
+  disable_local_ca_jwt = true
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend = "${vault_kubernetes_secret_backend.test.path}"
+  name = "%s"
+  allowed_kubernetes_namespaces = ["default"]
+  service_account_name = "default"
+  token_default_ttl = 3600
+}
+
+data "vault_kubernetes_service_account_token" "test" {
+  backend = "${vault_kubernetes_secret_backend.test.path}"
+  role = "${vault_kubernetes_secret_backend_role.test.name}"
+  kubernetes_namespace = "default"
+}
+`, backend, host, jwt, name)
+}
@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceLDAPDynamicCredentials_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLDAPDynamicCredentialsConfig_basic(backend, url, binddn, bindpass, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_ldap_dynamic_credentials.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_ldap_dynamic_credentials.test", "role_name", name),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "username"),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "password"),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLDAPDynamicCredentialsConfig_basic(backend, url, binddn, bindpass, name string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "%s"
+  default_ttl = 3600
+
+  creation_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: learn
+sn: {{.Password | utf16le | base64}}
+userPassword: {{.Password}}
+EOT
+
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+}
+
+data "vault_ldap_dynamic_credentials" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "${vault_ldap_secret_backend_dynamic_role.test.role_name}"
+}
+`, backend, url, binddn, bindpass, name)
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapStaticCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ldapStaticCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "LDAP Secret Backend to read credentials from.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the static role.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the static role's username.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Distinguished name (DN) of the existing LDAP entry to manage password rotation for.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password for the static role.",
+			},
+			"last_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Last known password for the static role.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How often Vault should rotate the password of the user entry, in seconds.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last password rotation by Vault.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Duration in seconds after which the issued credential should expire.",
+			},
+		},
+	}
+}
+
+func ldapStaticCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+	path := strings.Trim(backend, "/") + "/static-cred/" + role
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+	if secret == nil {
+		return fmt.Errorf("no static role found at path %q", path)
+	}
+
+	// the password is sensitive, so it must not be used as the ID
+	d.SetId(path)
+	for _, k := range []string{"username", "dn", "password", "last_password", "rotation_period", "last_vault_rotation", "ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceLDAPStaticCredentials_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	username := os.Getenv("LDAP_STATIC_USERNAME")
+	if username == "" {
+		t.Skip("LDAP_STATIC_USERNAME not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLDAPStaticCredentialsConfig_basic(backend, url, binddn, bindpass, name, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_ldap_static_credentials.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_ldap_static_credentials.test", "role_name", name),
+					resource.TestCheckResourceAttr("data.vault_ldap_static_credentials.test", "username", username),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_static_credentials.test", "password"),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_static_credentials.test", "last_vault_rotation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLDAPStaticCredentialsConfig_basic(backend, url, binddn, bindpass, name, username string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_static_role" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "%s"
+  username = "%s"
+  rotation_period = 3600
+}
+
+data "vault_ldap_static_credentials" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "${vault_ldap_secret_backend_static_role.test.role_name}"
+}
+`, backend, url, binddn, bindpass, name, username)
+}
@@ -0,0 +1,134 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func leasedGenericSecretDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: leasedGenericSecretDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path from which the leased secret will be read.",
+			},
+
+			"renew_increment": {
+				Type:        schema.TypeInt,
+				Required:    false,
+				Optional:    true,
+				Description: "If set, renew the lease to this increment in seconds after reading, so the credentials survive long runs.",
+			},
+
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "JSON-encoded secret data read from Vault.",
+			},
+
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Map of strings read from Vault.",
+			},
+
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func leasedGenericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Reading %s from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no secret found at %q", path)
+	}
+
+	d.SetId(secret.RequestID)
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonDataBytes, _ := json.Marshal(secret.Data)
+	d.Set("data_json", string(jsonDataBytes))
+
+	// Since our "data" map can only contain string values, we
+	// will take strings from Data and write them in as-is,
+	// and write everything else in as a JSON serialization of
+	// whatever value we get so that complex types can be
+	// passed around and processed elsewhere if desired.
+	dataMap := map[string]string{}
+	for k, v := range secret.Data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			// Again ignoring error because we know this value
+			// came from JSON in the first place and so must be valid.
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	d.Set("data", dataMap)
+
+	leaseDuration := secret.LeaseDuration
+
+	// Renew the lease up front so the credentials outlive a long apply.
+	if v, ok := d.GetOk("renew_increment"); ok && secret.Renewable && secret.LeaseID != "" {
+		increment := v.(int)
+
+		log.Printf("[DEBUG] Renewing lease %q with increment %d", secret.LeaseID, increment)
+		renewed, err := client.Sys().Renew(secret.LeaseID, increment)
+		if err != nil {
+			return fmt.Errorf("error renewing lease %q: %s", secret.LeaseID, err)
+		}
+		log.Printf("[DEBUG] Renewed lease %q, new lease duration %d", secret.LeaseID, renewed.LeaseDuration)
+
+		leaseDuration = renewed.LeaseDuration
+	}
+
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", leaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
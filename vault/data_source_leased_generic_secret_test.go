@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceLeasedGenericSecret_basic(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLeasedGenericSecretConfig_basic(backend, connURL, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_leased_generic_secret.test", "lease_id"),
+					resource.TestCheckResourceAttrSet("data.vault_leased_generic_secret.test", "lease_duration"),
+					resource.TestCheckResourceAttrSet("data.vault_leased_generic_secret.test", "data.username"),
+					resource.TestCheckResourceAttrSet("data.vault_leased_generic_secret.test", "data.password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLeasedGenericSecretConfig_basic(backend, connURL, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "mysql"
+  allowed_roles = ["%s"]
+
+  mysql {
+    connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  default_ttl = 3600
+  creation_statements = ["CREATE USER '{{name}}'@'%%' IDENTIFIED BY '{{password}}';GRANT SELECT ON *.* TO '{{name}}'@'%%';"]
+}
+
+data "vault_leased_generic_secret" "test" {
+  path = "${vault_mount.db.path}/creds/${vault_database_secret_backend_role.test.name}"
+}
+`, backend, name, connURL, name)
+}
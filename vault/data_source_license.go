@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func licenseDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: licenseDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"license_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the license.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start time of the license.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration time of the license.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The features enabled by the license.",
+			},
+			"autoloaded": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the license was autoloaded from the server's environment rather than stored.",
+			},
+		},
+	}
+}
+
+func licenseDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading license from Vault")
+	resp, err := client.Logical().Read(licensePath)
+	if err != nil {
+		return fmt.Errorf("error reading license: %s", err)
+	}
+	log.Printf("[DEBUG] Read license from Vault")
+
+	if resp == nil {
+		return fmt.Errorf("no license found at %q", licensePath)
+	}
+
+	d.SetId(client.Address() + "/" + licensePath)
+	d.Set("license_id", resp.Data["license_id"])
+	d.Set("start_time", resp.Data["start_time"])
+	d.Set("expiration_time", resp.Data["expiration_time"])
+	d.Set("features", resp.Data["features"])
+
+	// The status endpoint only exists on servers that support license
+	// autoloading; treat its absence as not autoloaded.
+	autoloaded := false
+	status, err := client.Logical().Read(licensePath + "/status")
+	if err == nil && status != nil {
+		if v, ok := status.Data["autoloading_used"].(bool); ok {
+			autoloaded = v
+		}
+	}
+	d.Set("autoloaded", autoloaded)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package vault
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceLicense_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLicenseConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_license.test", "license_id"),
+					resource.TestCheckResourceAttrSet("data.vault_license.test", "expiration_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLicenseConfig_basic = `
+data "vault_license" "test" {}
+`
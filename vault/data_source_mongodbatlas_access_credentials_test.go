@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasAccessCredentials_basic(t *testing.T) {
+	publicKey, privateKey := getTestMongoDBAtlasCreds(t)
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	if projectID == "" {
+		t.Skip("MONGODB_ATLAS_PROJECT_ID not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasAccessCredentialsConfig_basic(backend, publicKey, privateKey, name, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_mongodbatlas_access_credentials.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_mongodbatlas_access_credentials.test", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_mongodbatlas_access_credentials.test", "public_key"),
+					resource.TestCheckResourceAttrSet("data.vault_mongodbatlas_access_credentials.test", "private_key"),
+					resource.TestCheckResourceAttrSet("data.vault_mongodbatlas_access_credentials.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasAccessCredentialsConfig_basic(backend, publicKey, privateKey, name, projectID string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path = "%s"
+  public_key = "%s"
+  private_key = "%s"
+}
+
+resource "vault_mongodbatlas_secret_backend_role" "test" {
+  backend = "${vault_mongodbatlas_secret_backend.test.path}"
+  name = "%s"
+  project_id = "%s"
+  roles = ["GROUP_READ_ONLY"]
+}
+
+data "vault_mongodbatlas_access_credentials" "test" {
+  backend = "${vault_mongodbatlas_secret_backend.test.path}"
+  role = "${vault_mongodbatlas_secret_backend_role.test.name}"
+}
+`, backend, publicKey, privateKey, name, projectID)
+}
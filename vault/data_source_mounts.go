@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func mountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: mountsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"mounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of secret backend mounts, sorted by path.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Where the secret backend is mounted.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the backend, such as 'aws'.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-friendly description of the mount.",
+						},
+						"accessor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Accessor of the mount.",
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Mount type specific options that are passed to the backend.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mountsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mounts from Vault: %s", err)
+	}
+
+	paths := make([]string, 0, len(mounts))
+	for path := range mounts {
+		paths = append(paths, path)
+	}
+	// The API returns mounts keyed by path; sort so the list is stable
+	// across reads.
+	sort.Strings(paths)
+
+	result := make([]map[string]interface{}, 0, len(mounts))
+	for _, path := range paths {
+		mount := mounts[path]
+		result = append(result, map[string]interface{}{
+			"path":        strings.TrimSuffix(path, "/"),
+			"type":        mount.Type,
+			"description": mount.Description,
+			"accessor":    mount.Accessor,
+			"options":     mount.Options,
+		})
+	}
+
+	if err := d.Set("mounts", result); err != nil {
+		return fmt.Errorf("error setting mounts: %s", err)
+	}
+
+	// There is no canonical identifier for the full set of mounts, so scope
+	// the ID to the server being queried.
+	d.SetId(client.Address() + "/sys/mounts")
+
+	return nil
+}
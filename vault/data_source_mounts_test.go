@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceMounts_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-mount")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMountsConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_mounts.test", "mounts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMountsConfig_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+data "vault_mounts" "test" {
+  depends_on = ["vault_mount.test"]
+}
+`, path)
+}
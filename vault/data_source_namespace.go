@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func namespaceDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: namespaceDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Path of the namespace.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the namespace.",
+			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Custom metadata describing this namespace.",
+			},
+		},
+	}
+}
+
+func namespaceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Reading namespace %q from Vault", path)
+	resp, err := client.Logical().Read("sys/namespaces/" + path)
+	if err != nil {
+		return fmt.Errorf("error reading namespace %q from Vault: %s", path, err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("namespace %q not found", path)
+	}
+
+	d.SetId(strings.TrimSuffix(resp.Data["path"].(string), "/"))
+	d.Set("namespace_id", resp.Data["id"])
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		d.Set("custom_metadata", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceNamespace_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	path := acctest.RandomWithPrefix("tf-test-namespace")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNamespaceConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_namespace.test", "path", path),
+					resource.TestCheckResourceAttrSet("data.vault_namespace.test", "namespace_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNamespaceConfig_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = "%s"
+}
+
+data "vault_namespace" "test" {
+  path = "${vault_namespace.test.path}"
+}
+`, path)
+}
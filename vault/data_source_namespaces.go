@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func namespacesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: namespacesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"paths": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "Namespace paths.",
+			},
+		},
+	}
+}
+
+func namespacesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Listing namespaces from Vault")
+	resp, err := client.Logical().List("sys/namespaces")
+	if err != nil {
+		return fmt.Errorf("error listing namespaces from Vault: %s", err)
+	}
+
+	paths := []string{}
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, key := range keys {
+				paths = append(paths, strings.TrimSuffix(key.(string), "/"))
+			}
+		}
+	}
+
+	// The ID is the path from which the namespaces were listed, scoped to
+	// the address so that different providers do not collide.
+	d.SetId(client.Address() + "/sys/namespaces")
+	d.Set("paths", paths)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceNomadAccessToken_basic(t *testing.T) {
+	address, token := getTestNomadCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadAccessTokenConfig_basic(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_nomad_access_token.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_nomad_access_token.test", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.test", "accessor_id"),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.test", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadAccessTokenConfig_basic(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  backend = "%s"
+  address = "%s"
+  token = "%s"
+}
+
+resource "vault_nomad_secret_role" "test" {
+  backend = "${vault_nomad_secret_backend.test.backend}"
+  role = "%s"
+  policies = ["readonly"]
+}
+
+data "vault_nomad_access_token" "test" {
+  backend = "${vault_nomad_secret_backend.test.backend}"
+  role = "${vault_nomad_secret_role.test.role}"
+}
+`, backend, address, token, name)
+}
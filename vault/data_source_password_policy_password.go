@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func passwordPolicyPasswordDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: passwordPolicyPasswordDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the password policy to generate a password from.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password.",
+			},
+		},
+	}
+}
+
+func passwordPolicyPasswordDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := passwordPolicyPath(name) + "/generate"
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+	if secret == nil {
+		return fmt.Errorf("no password policy found at path %q", path)
+	}
+
+	// a fresh password is generated on every read, so the path is used as
+	// the ID.
+	d.SetId(path)
+	d.Set("password", secret.Data["password"])
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourcePasswordPolicyPassword_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-policy")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePasswordPolicyPasswordConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_password_policy_password.test", "name", name),
+					resource.TestCheckResourceAttrSet("data.vault_password_policy_password.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePasswordPolicyPasswordConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "vault_password_policy" "test" {
+  name = "%s"
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcdefghijklmnopqrstuvwxyz"
+  min-chars = 1
+}
+EOT
+}
+
+data "vault_password_policy_password" "test" {
+  name = "${vault_password_policy.test.name}"
+}
+`, name)
+}
@@ -0,0 +1,209 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the role to sign the certificate against. Not required when sign_verbatim is set.",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CSR.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CN of the certificate to create.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of other SANs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alterative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Time to leave.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of data.",
+				Default:      "pem",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"sign_verbatim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, the CSR is signed as-is using the sign-verbatim endpoint, ignoring the role's subject and SAN restrictions.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certicate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	signVerbatim := d.Get("sign_verbatim").(bool)
+
+	var path string
+	if signVerbatim {
+		path = strings.Trim(backend, "/") + "/sign-verbatim"
+		if name != "" {
+			path = path + "/" + strings.Trim(name, "/")
+		}
+	} else {
+		if name == "" {
+			return fmt.Errorf("name is required unless sign_verbatim is set")
+		}
+		path = pkiSecretBackendIssuePath(backend, name)
+	}
+
+	iAltNames := d.Get("alt_names").([]interface{})
+	altNames := make([]string, 0, len(iAltNames))
+	for _, iAltName := range iAltNames {
+		altNames = append(altNames, iAltName.(string))
+	}
+
+	iOtherSans := d.Get("other_sans").([]interface{})
+	otherSans := make([]string, 0, len(iOtherSans))
+	for _, iOtherSan := range iOtherSans {
+		otherSans = append(otherSans, iOtherSan.(string))
+	}
+
+	iIPSans := d.Get("ip_sans").([]interface{})
+	ipSans := make([]string, 0, len(iIPSans))
+	for _, iIpSan := range iIPSans {
+		ipSans = append(ipSans, iIpSan.(string))
+	}
+
+	iURISans := d.Get("uri_sans").([]interface{})
+	uriSans := make([]string, 0, len(iURISans))
+	for _, iUriSan := range iURISans {
+		uriSans = append(uriSans, iUriSan.(string))
+	}
+
+	data := map[string]interface{}{
+		"csr":                  d.Get("csr").(string),
+		"ttl":                  d.Get("ttl").(string),
+		"format":               d.Get("format").(string),
+		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
+	}
+
+	if v, ok := d.GetOk("common_name"); ok {
+		data["common_name"] = v.(string)
+	}
+
+	if len(altNames) > 0 {
+		data["alt_names"] = strings.Join(altNames, ",")
+	}
+
+	if len(otherSans) > 0 {
+		data["other_sans"] = strings.Join(otherSans, ",")
+	}
+
+	if len(ipSans) > 0 {
+		data["ip_sans"] = strings.Join(ipSans, ",")
+	}
+
+	if len(uriSans) > 0 {
+		data["uri_sans"] = strings.Join(uriSans, ",")
+	}
+
+	log.Printf("[DEBUG] Signing CSR on PKI secret backend %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing CSR on PKI secret backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Signed CSR on PKI secret backend %q", path)
+
+	d.SetId(fmt.Sprintf("%s/%v", path, resp.Data["serial_number"]))
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuing_ca", resp.Data["issuing_ca"])
+	d.Set("ca_chain", resp.Data["ca_chain"])
+	d.Set("serial", resp.Data["serial_number"])
+	d.Set("expiration", resp.Data["expiration"])
+
+	return nil
+}
@@ -24,6 +24,18 @@ type PolicyRule struct {
 	RequiredParameters []string
 	AllowedParameters  map[string][]string
 	DeniedParameters   map[string][]string
+	ControlGroup       *PolicyRuleControlGroup
+}
+
+type PolicyRuleControlGroup struct {
+	TTL     string
+	Factors []*PolicyRuleControlGroupFactor
+}
+
+type PolicyRuleControlGroupFactor struct {
+	Name       string
+	GroupNames []string
+	Approvals  int
 }
 
 var allowedCapabilities = []string{"create", "read", "update", "delete", "list", "sudo", "deny"}
@@ -117,6 +129,47 @@ func policyDocumentDataSource() *schema.Resource {
 								},
 							},
 						},
+
+						"control_group": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"factor": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"group_names": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+
+												"approvals": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													Default:  1,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -171,6 +224,10 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 
+			if controlGroupIntfs := rawRule["control_group"].([]interface{}); len(controlGroupIntfs) > 0 {
+				rule.ControlGroup = policyDecodeControlGroup(controlGroupIntfs[0].(map[string]interface{}))
+			}
+
 			log.Printf("[DEBUG] Rule is: %#v", rule)
 
 			rules[i] = rule
@@ -224,6 +281,23 @@ func policyDecodeConfigListOfMapsOfListToString(input []interface{}) (map[string
 	return output, nil
 }
 
+func policyDecodeControlGroup(rawControlGroup map[string]interface{}) *PolicyRuleControlGroup {
+	controlGroup := &PolicyRuleControlGroup{
+		TTL: rawControlGroup["ttl"].(string),
+	}
+
+	for _, factorI := range rawControlGroup["factor"].([]interface{}) {
+		rawFactor := factorI.(map[string]interface{})
+		controlGroup.Factors = append(controlGroup.Factors, &PolicyRuleControlGroupFactor{
+			Name:       rawFactor["name"].(string),
+			GroupNames: policyDecodeConfigListOfStrings(rawFactor["group_names"].([]interface{})),
+			Approvals:  rawFactor["approvals"].(int),
+		})
+	}
+
+	return controlGroup
+}
+
 func policyRenderListOfStrings(items []string) string {
 	if len(items) > 0 {
 		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
@@ -276,6 +350,24 @@ func policyRenderPolicyRule(rule *PolicyRule) string {
 		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
 	}
 
+	if rule.ControlGroup != nil {
+		renderedRule = fmt.Sprintf("%s  control_group = {\n", renderedRule)
+
+		if rule.ControlGroup.TTL != "" {
+			renderedRule = fmt.Sprintf("%s    ttl = \"%s\"\n", renderedRule, rule.ControlGroup.TTL)
+		}
+
+		for _, factor := range rule.ControlGroup.Factors {
+			renderedRule = fmt.Sprintf("%s    factor \"%s\" {\n", renderedRule, factor.Name)
+			renderedRule = fmt.Sprintf("%s      identity {\n", renderedRule)
+			renderedRule = fmt.Sprintf("%s        group_names = %s\n", renderedRule, policyRenderListOfStrings(factor.GroupNames))
+			renderedRule = fmt.Sprintf("%s        approvals = %d\n", renderedRule, factor.Approvals)
+			renderedRule = fmt.Sprintf("%s      }\n    }\n", renderedRule)
+		}
+
+		renderedRule = fmt.Sprintf("%s  }\n", renderedRule)
+	}
+
 	return fmt.Sprintf("%s}\n", renderedRule)
 }
 
@@ -284,7 +376,7 @@ func renderPolicy(policy *Policy) string {
 
 	for i, rule := range policy.Rules {
 		if i == 0 {
-			output = fmt.Sprintf("%s", policyRenderPolicyRule(rule))
+			output = policyRenderPolicyRule(rule)
 		} else {
 			output = fmt.Sprintf("%s\n%s", output, policyRenderPolicyRule(rule))
 		}
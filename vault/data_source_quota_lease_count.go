@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func quotaLeaseCountDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: quotaLeaseCountDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the quota.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path of the mount or namespace the quota is applied to.",
+			},
+			"max_leases": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of leases allowed by the quota rule.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The role the quota restricts login requests to.",
+			},
+			"counter": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current number of leases counted against the quota.",
+			},
+		},
+	}
+}
+
+func quotaLeaseCountDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := quotaLeaseCountPath(name)
+
+	log.Printf("[DEBUG] Reading Resource Lease Count Quota %s", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Resource Lease Count Quota %s: %s", name, err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("lease count quota %q not found", name)
+	}
+
+	d.SetId(name)
+	for _, k := range []string{"path", "max_leases", "role", "counter"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
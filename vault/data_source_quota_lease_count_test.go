@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceQuotaLeaseCount_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	name := acctest.RandomWithPrefix("tf-test-quota")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceQuotaLeaseCountConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_quota_lease_count.test", "name", name),
+					resource.TestCheckResourceAttr("data.vault_quota_lease_count.test", "max_leases", "100"),
+					resource.TestCheckResourceAttrSet("data.vault_quota_lease_count.test", "counter"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceQuotaLeaseCountConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "vault_quota_lease_count" "test" {
+  name = "%s"
+  max_leases = 100
+}
+
+data "vault_quota_lease_count" "test" {
+  name = "${vault_quota_lease_count.test.name}"
+}
+`, name)
+}
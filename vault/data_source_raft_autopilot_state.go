@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const raftAutopilotStatePath = "sys/storage/raft/autopilot/state"
+
+func raftAutopilotStateDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: raftAutopilotStateDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Health status of the cluster.",
+			},
+			"failure_tolerance": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How many nodes could fail before the cluster becomes unhealthy.",
+			},
+			"leader": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current leader of Vault.",
+			},
+			"voters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The voters in the Vault cluster.",
+			},
+			"servers_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The servers in the Vault cluster, as a JSON string.",
+			},
+		},
+	}
+}
+
+func raftAutopilotStateDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading raft autopilot state %q", raftAutopilotStatePath)
+	resp, err := client.Logical().Read(raftAutopilotStatePath)
+	if err != nil {
+		return fmt.Errorf("error reading raft autopilot state: %s", err)
+	}
+	log.Printf("[DEBUG] Read raft autopilot state %q", raftAutopilotStatePath)
+
+	if resp == nil {
+		return fmt.Errorf("no raft autopilot state found at %q", raftAutopilotStatePath)
+	}
+
+	d.SetId(client.Address() + "/" + raftAutopilotStatePath)
+	d.Set("healthy", resp.Data["healthy"])
+	d.Set("failure_tolerance", resp.Data["failure_tolerance"])
+	d.Set("leader", resp.Data["leader"])
+	d.Set("voters", resp.Data["voters"])
+
+	// servers is a nested object keyed by node ID; serialize it rather than
+	// trying to model its schema.
+	if servers, ok := resp.Data["servers"]; ok {
+		serversJSON, err := json.Marshal(servers)
+		if err != nil {
+			return fmt.Errorf("error serializing raft autopilot servers: %s", err)
+		}
+		d.Set("servers_json", string(serversJSON))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceRaftAutopilotState_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_RAFT") == "" {
+		t.Skip("TF_ACC_RAFT is not set, test is applicable only for Vault with integrated (raft) storage")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRaftAutopilotStateConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_raft_autopilot_state.test", "healthy"),
+					resource.TestCheckResourceAttrSet("data.vault_raft_autopilot_state.test", "leader"),
+					resource.TestCheckResourceAttrSet("data.vault_raft_autopilot_state.test", "servers_json"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRaftAutopilotStateConfig_basic = `
+data "vault_raft_autopilot_state" "test" {}
+`
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sealStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sealStatusDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the seal.",
+			},
+			"initialized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster is initialized.",
+			},
+			"sealed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is sealed.",
+			},
+			"threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares required to reconstruct the master key.",
+			},
+			"number_of_shares": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of key shares.",
+			},
+			"progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unseal key shares provided so far.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vault server version.",
+			},
+			"migration": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a seal migration is in progress.",
+			},
+			"recovery_seal": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the seal uses recovery keys.",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the cluster.",
+			},
+		},
+	}
+}
+
+func sealStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading seal status from Vault")
+	status, err := client.Sys().SealStatus()
+	if err != nil {
+		return fmt.Errorf("error reading seal status from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read seal status from Vault")
+
+	d.SetId(client.Address() + "/sys/seal-status")
+
+	d.Set("type", status.Type)
+	d.Set("initialized", status.Initialized)
+	d.Set("sealed", status.Sealed)
+	d.Set("threshold", status.T)
+	d.Set("number_of_shares", status.N)
+	d.Set("progress", status.Progress)
+	d.Set("version", status.Version)
+	d.Set("migration", status.Migration)
+	d.Set("recovery_seal", status.RecoverySeal)
+	d.Set("cluster_name", status.ClusterName)
+	d.Set("cluster_id", status.ClusterID)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceSealStatus_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSealStatusConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_seal_status.test", "sealed", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_seal_status.test", "type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSealStatusConfig_basic = `
+data "vault_seal_status" "test" {}
+`
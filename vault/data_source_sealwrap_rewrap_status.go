@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sealwrapRewrapStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sealwrapRewrapStatusDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"is_running": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rewrap process is currently running.",
+			},
+			"entries_processed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries processed by the rewrap process.",
+			},
+			"entries_succeeded": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries successfully rewrapped.",
+			},
+			"entries_failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries that failed to rewrap.",
+			},
+		},
+	}
+}
+
+func sealwrapRewrapStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading seal rewrap status %q", sealwrapRewrapPath)
+	resp, err := client.Logical().Read(sealwrapRewrapPath)
+	if err != nil {
+		return fmt.Errorf("error reading seal rewrap status: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no seal rewrap status found at %q", sealwrapRewrapPath)
+	}
+	log.Printf("[DEBUG] Read seal rewrap status %q", sealwrapRewrapPath)
+
+	d.SetId(client.Address() + "/" + sealwrapRewrapPath)
+
+	if v, ok := resp.Data["is_running"].(bool); ok {
+		d.Set("is_running", v)
+	}
+
+	if entries, ok := resp.Data["entries"].(map[string]interface{}); ok {
+		for field, attr := range map[string]string{
+			"processed": "entries_processed",
+			"succeeded": "entries_succeeded",
+			"failed":    "entries_failed",
+		} {
+			if v, ok := entries[field].(json.Number); ok {
+				count, err := v.Int64()
+				if err != nil {
+					return fmt.Errorf("error parsing entries.%s %q: %s", field, v, err)
+				}
+				d.Set(attr, count)
+			}
+		}
+	}
+
+	return nil
+}
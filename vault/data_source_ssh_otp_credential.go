@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshOTPCredentialDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshOTPCredentialDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the SSH secret backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the name of the OTP role to create the credential against.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the IP of the remote host the credential is for.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the username on the remote host; defaults to the default username of the role.",
+			},
+			"otp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The one-time password generated for the credential.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port on the remote host the credential is valid for.",
+			},
+		},
+	}
+}
+
+func sshOTPCredentialDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := strings.Trim(backend, "/") + "/creds/" + role
+
+	data := map[string]interface{}{
+		"ip": d.Get("ip").(string),
+	}
+	if v, ok := d.GetOk("username"); ok {
+		data["username"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Generating SSH OTP credential from role %q on backend %q", role, backend)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating SSH OTP credential from role %q on backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Generated SSH OTP credential from role %q on backend %q", role, backend)
+
+	keyType, ok := secret.Data["key_type"].(string)
+	if !ok || keyType != "otp" {
+		return fmt.Errorf("role %q on backend %q is not an OTP role", role, backend)
+	}
+
+	port := 0
+	if v, ok := secret.Data["port"]; ok {
+		portNumber, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing port for SSH OTP credential: %s", err)
+		}
+		port = int(portNumber)
+	}
+
+	// the OTP itself is sensitive, so it must not be used as the ID
+	d.SetId(path + "/" + secret.Data["username"].(string) + "@" + secret.Data["ip"].(string))
+	d.Set("otp", secret.Data["key"])
+	d.Set("username", secret.Data["username"])
+	d.Set("ip", secret.Data["ip"])
+	d.Set("port", port)
+
+	return nil
+}
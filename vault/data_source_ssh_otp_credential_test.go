@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceSSHOTPCredential_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSSHOTPCredentialConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_ssh_otp_credential.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_ssh_otp_credential.test", "ip", "10.0.0.5"),
+					resource.TestCheckResourceAttr("data.vault_ssh_otp_credential.test", "username", "ubuntu"),
+					resource.TestCheckResourceAttrSet("data.vault_ssh_otp_credential.test", "otp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSSHOTPCredentialConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "ssh" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend = "${vault_mount.ssh.path}"
+  name = "test"
+  key_type = "otp"
+  default_user = "ubuntu"
+  cidr_list = "10.0.0.0/24"
+}
+
+data "vault_ssh_otp_credential" "test" {
+  backend = "${vault_mount.ssh.path}"
+  role = "${vault_ssh_secret_backend_role.test.name}"
+  ip = "10.0.0.5"
+  username = "ubuntu"
+}
+`, backend)
+}
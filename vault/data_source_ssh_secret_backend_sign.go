@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSecretBackendSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the SSH secret backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the name of the role to sign.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the SSH public key that should be signed.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the requested time to live for a certificate.",
+			},
+			"valid_principals": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies valid principals, either usernames or hostnames, that the certificate should be signed for.",
+			},
+			"cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "user",
+				Description:  "Specifies the type of certificate to be created; either \"user\" or \"host\".",
+				ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the key id that the created certificate should have.",
+			},
+			"critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Specifies a map of the critical options that the certificate should be signed for.",
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Specifies a map of the extensions that the certificate should be signed for.",
+			},
+			"signed_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed certificate in OpenSSH format.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the signed certificate.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := strings.Trim(backend, "/") + "/sign/" + name
+
+	data := map[string]interface{}{
+		"public_key": d.Get("public_key").(string),
+		"cert_type":  d.Get("cert_type").(string),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+	if v, ok := d.GetOk("valid_principals"); ok {
+		data["valid_principals"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_id"); ok {
+		data["key_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("critical_options"); ok {
+		data["critical_options"] = v
+	}
+	if v, ok := d.GetOk("extensions"); ok {
+		data["extensions"] = v
+	}
+
+	log.Printf("[DEBUG] Signing SSH public key with role %q on backend %q", name, backend)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing SSH public key with role %q on backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Signed SSH public key with role %q on backend %q", name, backend)
+
+	serialNumber := secret.Data["serial_number"].(string)
+
+	d.SetId(path + "/" + serialNumber)
+	d.Set("signed_key", secret.Data["signed_key"])
+	d.Set("serial_number", serialNumber)
+
+	return nil
+}
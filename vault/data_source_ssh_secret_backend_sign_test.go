@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const testAccSSHSignPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7/n+wNKpUxXpRKOA+QZwcz1fcQ22AxTgAWsoAwJXzmpsaGBHD3Mmu68jFPr3n/SQsftSp4R8zGVjhcG4eRZG5TgON3lwAt6UcnzOYb5mVpFytCNVEzQ++fYPcFCxNJYghZLMuYu5pg4YEyuuAGUYOtUtbzymSxiI9OvgF3Gor9PM7AspiPCVP5dXcdAvGvprv5IeTf/89apCGEhmz65o5KyDnFIG5THoQYkipJYFSIGEHo8nmd0ZUNFmSJKa6XqWn/hZy68CReIqocJEKc0BwEACEVQScvQmpD2DlCYjAQZz4vi2De/hCL4hTCWTwtGSStwSACPGLTgk7ZdcE/OUZ test@terraform-vault-provider.local"
+
+func TestAccDataSourceSSHSecretBackendSign_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSSHSecretBackendSignConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_ssh_secret_backend_sign.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_ssh_secret_backend_sign.test", "cert_type", "user"),
+					resource.TestCheckResourceAttrSet("data.vault_ssh_secret_backend_sign.test", "signed_key"),
+					resource.TestCheckResourceAttrSet("data.vault_ssh_secret_backend_sign.test", "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSSHSecretBackendSignConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "ssh" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend = "${vault_mount.ssh.path}"
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend = "${vault_mount.ssh.path}"
+  name = "test"
+  key_type = "ca"
+  allow_user_certificates = true
+  allowed_users = "*"
+  default_user = "ubuntu"
+}
+
+data "vault_ssh_secret_backend_sign" "test" {
+  depends_on = [ "vault_ssh_secret_backend_ca.test" ]
+  backend = "${vault_mount.ssh.path}"
+  name = "${vault_ssh_secret_backend_role.test.name}"
+  public_key = "%s"
+  valid_principals = "ubuntu"
+}
+`, backend, testAccSSHSignPublicKey)
+}
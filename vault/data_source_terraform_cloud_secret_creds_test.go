@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceTerraformCloudSecretCreds_basic(t *testing.T) {
+	token := getTestTFCToken(t)
+	organization := os.Getenv("TFC_ORGANIZATION")
+	if organization == "" {
+		t.Skip("TFC_ORGANIZATION not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-terraform")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTerraformCloudSecretCredsConfig_basic(backend, token, name, organization),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_terraform_cloud_secret_creds.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_terraform_cloud_secret_creds.test", "role", name),
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_secret_creds.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_secret_creds.test", "token_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTerraformCloudSecretCredsConfig_basic(backend, token, name, organization string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  backend = "%s"
+  token = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend = "${vault_terraform_cloud_secret_backend.test.backend}"
+  name = "%s"
+  organization = "%s"
+}
+
+data "vault_terraform_cloud_secret_creds" "test" {
+  backend = "${vault_terraform_cloud_secret_backend.test.backend}"
+  role = "${vault_terraform_cloud_secret_role.test.name}"
+}
+`, backend, token, name, organization)
+}
@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceTOTPCode_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTOTPCodeConfig_basic(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_totp_code.test", "backend", backend),
+					resource.TestCheckResourceAttr("data.vault_totp_code.test", "name", name),
+					resource.TestCheckResourceAttrSet("data.vault_totp_code.test", "code"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTOTPCodeConfig_basic(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = "${vault_mount.totp.path}"
+  name = "%s"
+  generate = true
+  issuer = "test-issuer"
+  account_name = "test-account"
+}
+
+data "vault_totp_code" "test" {
+  backend = "${vault_mount.totp.path}"
+  name = "${vault_totp_secret_backend_key.test.name}"
+}
+`, backend, name)
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeValidationDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeValidationDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The TOTP secret backend to validate codes against.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key to validate the code against.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The TOTP code to validate.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the code was valid for the key at the time of the check.",
+			},
+		},
+	}
+}
+
+func totpCodeValidationDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := strings.Trim(backend, "/") + "/code/" + name
+
+	data := map[string]interface{}{
+		"code": d.Get("code").(string),
+	}
+
+	log.Printf("[DEBUG] Validating code against %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error validating code against %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Validated code against %q", path)
+	if secret == nil {
+		return fmt.Errorf("no key found at path %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("valid", secret.Data["valid"])
+
+	return nil
+}
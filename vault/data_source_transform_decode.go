@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformDecodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transformDecodeDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The mount path of the transform secret backend.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to decode with.",
+			},
+			"transformation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The transformation to use; required if the role has more than one.",
+			},
+			"batch_input": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "A list of items to decode in a single batch call.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The per-item results of the batch decode call.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func transformDecodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("path").(string), "/")
+	role := d.Get("role_name").(string)
+	path := backend + "/decode/" + role
+
+	data := map[string]interface{}{
+		"batch_input": transformBatchInput(d),
+	}
+	if v, ok := d.GetOk("transformation"); ok {
+		data["transformation"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Decoding batch with role %q at %q", role, path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error decoding batch with role %q: %s", role, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no batch results returned from %q", path)
+	}
+	log.Printf("[DEBUG] Decoded batch with role %q at %q", role, path)
+
+	d.SetId(path)
+
+	if err := d.Set("batch_results", transformBatchResults(resp)); err != nil {
+		return fmt.Errorf("error setting batch_results: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceTransformDecode_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	path := acctest.RandomWithPrefix("tf-test-transform")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTransformDecodeConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transform_decode.test", "path", path),
+					resource.TestCheckResourceAttr("data.vault_transform_decode.test", "role_name", "payments"),
+					resource.TestCheckResourceAttr("data.vault_transform_decode.test", "batch_results.0.decoded_value", "1111-2222-3333-4444"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTransformDecodeConfig_basic(path string) string {
+	return testAccTransformBackendConfig(path) + `
+data "vault_transform_encode" "encoded" {
+  path = "${vault_mount.transform.path}"
+  role_name = "payments"
+  batch_input = [
+    {
+      value = "1111-2222-3333-4444"
+    },
+  ]
+
+  depends_on = ["vault_generic_endpoint.role"]
+}
+
+data "vault_transform_decode" "test" {
+  path = "${vault_mount.transform.path}"
+  role_name = "payments"
+  batch_input = [
+    {
+      value = "${data.vault_transform_encode.encoded.batch_results.0.encoded_value}"
+    },
+  ]
+}
+`
+}
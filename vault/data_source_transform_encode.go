@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformEncodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transformEncodeDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The mount path of the transform secret backend.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to encode with.",
+			},
+			"transformation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The transformation to use; required if the role has more than one.",
+			},
+			"batch_input": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "A list of items to encode in a single batch call.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The per-item results of the batch encode call.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func transformEncodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("path").(string), "/")
+	role := d.Get("role_name").(string)
+	path := backend + "/encode/" + role
+
+	data := map[string]interface{}{
+		"batch_input": transformBatchInput(d),
+	}
+	if v, ok := d.GetOk("transformation"); ok {
+		data["transformation"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Encoding batch with role %q at %q", role, path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error encoding batch with role %q: %s", role, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no batch results returned from %q", path)
+	}
+	log.Printf("[DEBUG] Encoded batch with role %q at %q", role, path)
+
+	d.SetId(path)
+
+	if err := d.Set("batch_results", transformBatchResults(resp)); err != nil {
+		return fmt.Errorf("error setting batch_results: %s", err)
+	}
+
+	return nil
+}
+
+func transformBatchInput(d *schema.ResourceData) []map[string]interface{} {
+	input := d.Get("batch_input").([]interface{})
+	output := make([]map[string]interface{}, len(input))
+	for i, itemI := range input {
+		output[i] = itemI.(map[string]interface{})
+	}
+	return output
+}
+
+func transformBatchResults(resp *api.Secret) []map[string]interface{} {
+	results, ok := resp.Data["batch_results"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	output := make([]map[string]interface{}, 0, len(results))
+	for _, resultI := range results {
+		result, ok := resultI.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		item := make(map[string]interface{}, len(result))
+		for k, v := range result {
+			item[k] = fmt.Sprintf("%v", v)
+		}
+		output = append(output, item)
+	}
+	return output
+}
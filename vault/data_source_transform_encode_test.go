@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceTransformEncode_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	path := acctest.RandomWithPrefix("tf-test-transform")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTransformEncodeConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transform_encode.test", "path", path),
+					resource.TestCheckResourceAttr("data.vault_transform_encode.test", "role_name", "payments"),
+					resource.TestCheckResourceAttrSet("data.vault_transform_encode.test", "batch_results.0.encoded_value"),
+				),
+			},
+		},
+	})
+}
+
+// There are no dedicated transform resources yet, so the transformation and
+// role are provisioned through vault_generic_endpoint.
+func testAccTransformBackendConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_generic_endpoint" "transformation" {
+  path = "${vault_mount.transform.path}/transformation/ccn-fpe"
+  disable_read = true
+  disable_delete = true
+  data_json = <<EOT
+{
+  "type": "fpe",
+  "template": "builtin/creditcardnumber",
+  "tweak_source": "internal",
+  "allowed_roles": ["payments"]
+}
+EOT
+}
+
+resource "vault_generic_endpoint" "role" {
+  path = "${vault_mount.transform.path}/role/payments"
+  disable_read = true
+  disable_delete = true
+  data_json = <<EOT
+{
+  "transformations": ["ccn-fpe"]
+}
+EOT
+
+  depends_on = ["vault_generic_endpoint.transformation"]
+}
+`, path)
+}
+
+func testAccDataSourceTransformEncodeConfig_basic(path string) string {
+	return testAccTransformBackendConfig(path) + `
+data "vault_transform_encode" "test" {
+  path = "${vault_mount.transform.path}"
+  role_name = "payments"
+  batch_input = [
+    {
+      value = "1111-2222-3333-4444"
+    },
+  ]
+
+  depends_on = ["vault_generic_endpoint.role"]
+}
+`
+}
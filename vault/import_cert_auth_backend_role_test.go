@@ -0,0 +1,34 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCertAuthBackendRole_importBasic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("cert")
+	name := acctest.RandomWithPrefix("test-cert")
+	allowedNames := []string{
+		acctest.RandomWithPrefix("allowed_server"),
+		acctest.RandomWithPrefix("allowed_server"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_basic(backend, name, testCertificate, allowedNames),
+				Check:  testCertAuthBackendCheck_attrs(backend, name),
+			},
+			{
+				ResourceName:      "vault_cert_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
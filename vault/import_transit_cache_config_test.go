@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTransitCacheConfig_importBasic(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-cache-config")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccTransitCacheConfigCheckDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitCacheConfig(name, 600),
+				Check:  resource.TestCheckResourceAttr("vault_transit_secret_cache_config.cfg", "size", "600"),
+			},
+			{
+				ResourceName:      "vault_transit_secret_cache_config.cfg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
@@ -151,6 +152,13 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", 2),
 				Description: "Maximum number of retries when a 5xx error code is encountered.",
 			},
+			"client_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_CLIENT_TIMEOUT", 60),
+				Description: "Seconds to wait for a single Vault request to complete. Raise it for long-running operations such as PKI root generation or slow database connection verification.",
+			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -187,10 +195,30 @@ type Description struct {
 
 var (
 	DataSourceRegistry = map[string]*Description{
+		"vault_activity_counters": {
+			Resource:      activityCountersDataSource(),
+			PathInventory: []string{"/sys/internal/counters/activity"},
+		},
+		"vault_alicloud_access_credentials": {
+			Resource:      alicloudAccessCredentialsDataSource(),
+			PathInventory: []string{"/alicloud/creds/{role}"},
+		},
+		"vault_consul_access_token": {
+			Resource:      consulAccessTokenDataSource(),
+			PathInventory: []string{"/consul/creds/{role}"},
+		},
 		"vault_approle_auth_backend_role_id": {
 			Resource:      approleAuthBackendRoleIDDataSource(),
 			PathInventory: []string{"/auth/approle/role/{role_name}/role-id"},
 		},
+		"vault_capabilities_self": {
+			Resource:      capabilitiesSelfDataSource(),
+			PathInventory: []string{"/sys/capabilities-self"},
+		},
+		"vault_health": {
+			Resource:      healthDataSource(),
+			PathInventory: []string{"/sys/health"},
+		},
 		"vault_identity_entity": {
 			Resource:      identityEntityDataSource(),
 			PathInventory: []string{"/identity/lookup/entity"},
@@ -199,6 +227,14 @@ var (
 			Resource:      identityGroupDataSource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_oidc_public_keys": {
+			Resource:      identityOidcPublicKeysDataSource(),
+			PathInventory: []string{"/identity/oidc/.well-known/keys"},
+		},
+		"vault_identity_oidc_token": {
+			Resource:      identityOidcTokenDataSource(),
+			PathInventory: []string{"/identity/oidc/token/{name}"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigDataSource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -207,33 +243,182 @@ var (
 			Resource:      kubernetesAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_service_account_token": {
+			Resource:      kubernetesServiceAccountTokenDataSource(),
+			PathInventory: []string{"/kubernetes/creds/{role}"},
+		},
+		"vault_license": {
+			Resource:       licenseDataSource(),
+			PathInventory:  []string{"/sys/license"},
+			EnterpriseOnly: true,
+		},
+		"vault_ldap_static_credentials": {
+			Resource:      ldapStaticCredentialsDataSource(),
+			PathInventory: []string{"/ldap/static-cred/{role}"},
+		},
+		"vault_ldap_dynamic_credentials": {
+			Resource:      ldapDynamicCredentialsDataSource(),
+			PathInventory: []string{"/ldap/creds/{role}"},
+		},
+		"vault_namespace": {
+			Resource:       namespaceDataSource(),
+			PathInventory:  []string{"/sys/namespaces/{path}"},
+			EnterpriseOnly: true,
+		},
+		"vault_namespaces": {
+			Resource:       namespacesDataSource(),
+			PathInventory:  []string{"/sys/namespaces"},
+			EnterpriseOnly: true,
+		},
+		"vault_nomad_access_token": {
+			Resource:      nomadAccessTokenDataSource(),
+			PathInventory: []string{"/nomad/creds/{role}"},
+		},
+		"vault_mongodbatlas_access_credentials": {
+			Resource:      mongodbAtlasAccessCredentialsDataSource(),
+			PathInventory: []string{"/mongodbatlas/creds/{role}"},
+		},
 		"vault_aws_access_credentials": {
 			Resource:      awsAccessCredentialsDataSource(),
 			PathInventory: []string{"/aws/creds"},
 		},
+		"vault_aws_static_access_credentials": {
+			Resource:      awsStaticAccessCredentialsDataSource(),
+			PathInventory: []string{"/aws/static-creds/{name}"},
+		},
 		"vault_azure_access_credentials": {
 			Resource:      azureAccessCredentialsDataSource(),
 			PathInventory: []string{"/azure/creds/{role}"},
 		},
+		"vault_database_secret_backend_creds": {
+			Resource:      databaseSecretBackendCredsDataSource(),
+			PathInventory: []string{"/database/creds/{name}"},
+		},
+		"vault_gcp_secret_access_token": {
+			Resource: gcpSecretAccessTokenDataSource(),
+			PathInventory: []string{
+				"/gcp/roleset/{roleset}/token",
+				"/gcp/static-account/{name}/token",
+				"/gcp/impersonated-account/{name}/token",
+			},
+		},
+		"vault_gcp_secret_service_account_key": {
+			Resource: gcpSecretServiceAccountKeyDataSource(),
+			PathInventory: []string{
+				"/gcp/roleset/{roleset}/key",
+				"/gcp/static-account/{name}/key",
+			},
+		},
 		"vault_generic_secret": {
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_leased_generic_secret": {
+			Resource:      leasedGenericSecretDataSource(),
+			PathInventory: []string{GenericPath},
+		},
+		"vault_pki_secret_backend_sign": {
+			Resource: pkiSecretBackendSignDataSource(),
+			PathInventory: []string{
+				"/pki/sign/{role}",
+				"/pki/sign-verbatim/{role}",
+			},
+		},
+		"vault_password_policy_password": {
+			Resource:      passwordPolicyPasswordDataSource(),
+			PathInventory: []string{"/sys/policies/password/{name}/generate"},
+		},
+		"vault_quota_lease_count": {
+			Resource:       quotaLeaseCountDataSource(),
+			PathInventory:  []string{"/sys/quotas/lease-count/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_raft_autopilot_state": {
+			Resource:      raftAutopilotStateDataSource(),
+			PathInventory: []string{"/sys/storage/raft/autopilot/state"},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
+		"vault_seal_status": {
+			Resource:      sealStatusDataSource(),
+			PathInventory: []string{"/sys/seal-status"},
+		},
+		"vault_sealwrap_rewrap_status": {
+			Resource:       sealwrapRewrapStatusDataSource(),
+			PathInventory:  []string{"/sys/sealwrap/rewrap"},
+			EnterpriseOnly: true,
+		},
+		"vault_ssh_otp_credential": {
+			Resource:      sshOTPCredentialDataSource(),
+			PathInventory: []string{"/ssh/creds/{role}"},
+		},
+		"vault_ssh_secret_backend_sign": {
+			Resource:      sshSecretBackendSignDataSource(),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
+		"vault_terraform_cloud_secret_creds": {
+			Resource:      terraformCloudSecretCredsDataSource(),
+			PathInventory: []string{"/terraform/creds/{role}"},
+		},
+		"vault_totp_code": {
+			Resource:      totpCodeDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
+		"vault_totp_code_validation": {
+			Resource:      totpCodeValidationDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
+		"vault_transform_decode": {
+			Resource:       transformDecodeDataSource(),
+			PathInventory:  []string{"/transform/decode/{role_name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_transform_encode": {
+			Resource:       transformEncodeDataSource(),
+			PathInventory:  []string{"/transform/encode/{role_name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_auth_backend": {
 			Resource:      authBackendDataSource(),
 			PathInventory: []string{"/sys/auth"},
 		},
+		"vault_auth_backends": {
+			Resource:      authBackendsDataSource(),
+			PathInventory: []string{"/sys/auth"},
+		},
+		"vault_mounts": {
+			Resource:      mountsDataSource(),
+			PathInventory: []string{"/sys/mounts"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
+		"vault_ad_secret_backend": {
+			Resource:      adSecretBackendResource(),
+			PathInventory: []string{"/ad/config"},
+		},
+		"vault_ad_secret_backend_role": {
+			Resource:      adSecretBackendRoleResource(),
+			PathInventory: []string{"/ad/roles/{role}"},
+		},
+		"vault_ad_secret_backend_library": {
+			Resource:      adSecretBackendLibraryResource(),
+			PathInventory: []string{"/ad/library/{name}"},
+		},
 		"vault_alicloud_auth_backend_role": {
 			Resource:      alicloudAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/alicloud/role/{name}"},
 		},
+		"vault_alicloud_secret_backend": {
+			Resource:      alicloudSecretBackendResource(),
+			PathInventory: []string{"/alicloud/config"},
+		},
+		"vault_alicloud_secret_backend_role": {
+			Resource:      alicloudSecretBackendRoleResource(),
+			PathInventory: []string{"/alicloud/role/{name}"},
+		},
 		"vault_approle_auth_backend_login": {
 			Resource:      approleAuthBackendLoginResource(),
 			PathInventory: []string{"/auth/approle/login"},
@@ -305,10 +490,22 @@ var (
 			Resource:      awsSecretBackendRoleResource(),
 			PathInventory: []string{"/aws/roles/{name}"},
 		},
+		"vault_aws_secret_backend_rotate_root": {
+			Resource:      awsSecretBackendRotateRootResource(),
+			PathInventory: []string{"/aws/config/rotate-root"},
+		},
+		"vault_aws_secret_backend_static_role": {
+			Resource:      awsSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/aws/static-roles/{name}"},
+		},
 		"vault_azure_secret_backend": {
 			Resource:      azureSecretBackendResource(),
 			PathInventory: []string{"/azure/config"},
 		},
+		"vault_azure_secret_backend_rotate_root": {
+			Resource:      azureSecretBackendRotateRootResource(),
+			PathInventory: []string{"/azure/rotate-root"},
+		},
 		"vault_azure_secret_backend_role": {
 			Resource:      azureSecretBackendRoleResource(),
 			PathInventory: []string{"/azure/roles/{name}"},
@@ -337,6 +534,10 @@ var (
 			Resource:      databaseSecretBackendRoleResource(),
 			PathInventory: []string{"/database/roles/{name}"},
 		},
+		"vault_database_secret_backend_rotate_root": {
+			Resource:      databaseSecretBackendRotateRootResource(),
+			PathInventory: []string{"/database/rotate-root/{name}"},
+		},
 		"vault_database_secret_backend_static_role": {
 			Resource:      databaseSecretBackendStaticRoleResource(),
 			PathInventory: []string{"/database/static-roles/{name}"},
@@ -365,6 +566,14 @@ var (
 			Resource:      gcpSecretBackendResource(),
 			PathInventory: []string{"/gcp/config"},
 		},
+		"vault_gcp_secret_impersonated_account": {
+			Resource:      gcpSecretImpersonatedAccountResource(),
+			PathInventory: []string{"/gcp/impersonated-account/{name}"},
+		},
+		"vault_gcp_secret_static_account": {
+			Resource:      gcpSecretStaticAccountResource(),
+			PathInventory: []string{"/gcp/static-account/{name}"},
+		},
 		"vault_gcp_secret_roleset": {
 			Resource:      gcpSecretRolesetResource(),
 			PathInventory: []string{"/gcp/roleset/{name}"},
@@ -373,6 +582,20 @@ var (
 			Resource:      certAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/cert/certs/{name}"},
 		},
+		"vault_config_control_group": {
+			Resource:       configControlGroupResource(),
+			PathInventory:  []string{"/sys/config/control-group"},
+			EnterpriseOnly: true,
+		},
+		"vault_config_ui_custom_message": {
+			Resource:       configUICustomMessageResource(),
+			PathInventory:  []string{"/sys/config/ui/custom-messages/{id}"},
+			EnterpriseOnly: true,
+		},
+		"vault_config_ui_header": {
+			Resource:      configUIHeaderResource(),
+			PathInventory: []string{"/sys/config/ui/headers/{name}"},
+		},
 		"vault_generic_endpoint": {
 			Resource:      genericEndpointResource(),
 			PathInventory: []string{GenericPath},
@@ -389,6 +612,34 @@ var (
 			Resource:      jwtAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/jwt/role/{name}"},
 		},
+		"vault_keyring_rotation": {
+			Resource:      keyringRotationResource(),
+			PathInventory: []string{"/sys/rotate"},
+		},
+		"vault_keyring_rotation_config": {
+			Resource:      keyringRotationConfigResource(),
+			PathInventory: []string{"/sys/rotate/config"},
+		},
+		"vault_kmip_secret_backend": {
+			Resource:       kmipSecretBackendResource(),
+			PathInventory:  []string{"/kmip", "/kmip/config"},
+			EnterpriseOnly: true,
+		},
+		"vault_kmip_secret_scope": {
+			Resource:       kmipSecretScopeResource(),
+			PathInventory:  []string{"/kmip/scope/{scope}"},
+			EnterpriseOnly: true,
+		},
+		"vault_kmip_secret_role": {
+			Resource:       kmipSecretRoleResource(),
+			PathInventory:  []string{"/kmip/scope/{scope}/role/{role}"},
+			EnterpriseOnly: true,
+		},
+		"vault_kmip_secret_credential": {
+			Resource:       kmipSecretCredentialResource(),
+			PathInventory:  []string{"/kmip/scope/{scope}/role/{role}/credential/generate"},
+			EnterpriseOnly: true,
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -397,6 +648,24 @@ var (
 			Resource:      kubernetesAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_secret_backend": {
+			Resource:      kubernetesSecretBackendResource(),
+			PathInventory: []string{"/kubernetes", "/kubernetes/config"},
+		},
+		"vault_kubernetes_secret_backend_role": {
+			Resource:      kubernetesSecretBackendRoleResource(),
+			PathInventory: []string{"/kubernetes/roles/{name}"},
+		},
+		"vault_license": {
+			Resource:       licenseResource(),
+			PathInventory:  []string{"/sys/license"},
+			EnterpriseOnly: true,
+		},
+		"vault_managed_keys": {
+			Resource:       managedKeysResource(),
+			PathInventory:  []string{"/sys/managed-keys/{type}/{name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_okta_auth_backend": {
 			Resource:      oktaAuthBackendResource(),
 			PathInventory: []string{"/auth/okta/config"},
@@ -421,6 +690,42 @@ var (
 			Resource:      ldapAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/ldap/groups/{name}"},
 		},
+		"vault_ldap_secret_backend": {
+			Resource:      ldapSecretBackendResource(),
+			PathInventory: []string{"/ldap/config"},
+		},
+		"vault_ldap_secret_backend_static_role": {
+			Resource:      ldapSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/ldap/static-role/{name}"},
+		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      ldapSecretBackendDynamicRoleResource(),
+			PathInventory: []string{"/ldap/role/{name}"},
+		},
+		"vault_ldap_secret_backend_library_set": {
+			Resource:      ldapSecretBackendLibrarySetResource(),
+			PathInventory: []string{"/ldap/library/{name}"},
+		},
+		"vault_ldap_secret_backend_library_checkout": {
+			Resource:      ldapSecretBackendLibraryCheckoutResource(),
+			PathInventory: []string{"/ldap/library/{name}/check-out", "/ldap/library/{name}/check-in"},
+		},
+		"vault_password_policy": {
+			Resource:      passwordPolicyResource(),
+			PathInventory: []string{"/sys/policies/password/{name}"},
+		},
+		"vault_plugin": {
+			Resource:      pluginResource(),
+			PathInventory: []string{"/sys/plugins/catalog/{type}/{name}"},
+		},
+		"vault_plugin_pinned_version": {
+			Resource:      pluginPinnedVersionResource(),
+			PathInventory: []string{"/sys/plugins/pins/{type}/{name}"},
+		},
+		"vault_plugin_runtime": {
+			Resource:      pluginRuntimeResource(),
+			PathInventory: []string{"/sys/plugins/runtimes/catalog/{type}/{name}"},
+		},
 		"vault_policy": {
 			Resource:      policyResource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -435,6 +740,26 @@ var (
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_mongodbatlas_secret_backend": {
+			Resource:      mongodbAtlasSecretBackendResource(),
+			PathInventory: []string{"/mongodbatlas/config"},
+		},
+		"vault_mongodbatlas_secret_backend_role": {
+			Resource:      mongodbAtlasSecretBackendRoleResource(),
+			PathInventory: []string{"/mongodbatlas/roles/{name}"},
+		},
+		"vault_nomad_secret_backend": {
+			Resource: nomadSecretBackendResource(),
+			PathInventory: []string{
+				"/nomad",
+				"/nomad/config/access",
+				"/nomad/config/lease",
+			},
+		},
+		"vault_nomad_secret_role": {
+			Resource:      nomadSecretRoleResource(),
+			PathInventory: []string{"/nomad/role/{role}"},
+		},
 		"vault_mfa_duo": {
 			Resource:       mfaDuoResource(),
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
@@ -453,6 +778,10 @@ var (
 			Resource:      auditResource(),
 			PathInventory: []string{"/sys/audit/{path}"},
 		},
+		"vault_audit_request_header": {
+			Resource:      auditRequestHeaderResource(),
+			PathInventory: []string{"/sys/config/auditing/request-headers/{name}"},
+		},
 		"vault_ssh_secret_backend_ca": {
 			Resource:      sshSecretBackendCAResource(),
 			PathInventory: []string{"/ssh/config/ca"},
@@ -485,6 +814,30 @@ var (
 			Resource:      identityGroupPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_group_member_entity_ids": {
+			Resource:      identityGroupMemberEntityIdsResource(),
+			PathInventory: []string{"/identity/lookup/group"},
+		},
+		"vault_identity_mfa_duo": {
+			Resource:      identityMfaDuoResource(),
+			PathInventory: []string{"/identity/mfa/method/duo/{id}"},
+		},
+		"vault_identity_mfa_okta": {
+			Resource:      identityMfaOktaResource(),
+			PathInventory: []string{"/identity/mfa/method/okta/{id}"},
+		},
+		"vault_identity_mfa_pingid": {
+			Resource:      identityMfaPingidResource(),
+			PathInventory: []string{"/identity/mfa/method/pingid/{id}"},
+		},
+		"vault_identity_mfa_totp": {
+			Resource:      identityMfaTotpResource(),
+			PathInventory: []string{"/identity/mfa/method/totp/{id}"},
+		},
+		"vault_identity_mfa_login_enforcement": {
+			Resource:      identityMfaLoginEnforcementResource(),
+			PathInventory: []string{"/identity/mfa/login-enforcement/{name}"},
+		},
 		"vault_identity_oidc": {
 			Resource:      identityOidc(),
 			PathInventory: []string{"/identity/oidc/config"},
@@ -501,6 +854,22 @@ var (
 			Resource:      identityOidcRole(),
 			PathInventory: []string{"/identity/oidc/role/{name}"},
 		},
+		"vault_identity_oidc_assignment": {
+			Resource:      identityOidcAssignment(),
+			PathInventory: []string{"/identity/oidc/assignment/{name}"},
+		},
+		"vault_identity_oidc_scope": {
+			Resource:      identityOidcScope(),
+			PathInventory: []string{"/identity/oidc/scope/{name}"},
+		},
+		"vault_identity_oidc_client": {
+			Resource:      identityOidcClient(),
+			PathInventory: []string{"/identity/oidc/client/{name}"},
+		},
+		"vault_identity_oidc_provider": {
+			Resource:      identityOidcProvider(),
+			PathInventory: []string{"/identity/oidc/provider/{name}"},
+		},
 		"vault_rabbitmq_secret_backend": {
 			Resource: rabbitmqSecretBackendResource(),
 			PathInventory: []string{
@@ -512,6 +881,58 @@ var (
 			Resource:      rabbitmqSecretBackendRoleResource(),
 			PathInventory: []string{"/rabbitmq/roles/{name}"},
 		},
+		"vault_radius_auth_backend": {
+			Resource:      radiusAuthBackendResource(),
+			PathInventory: []string{"/auth/radius/config"},
+		},
+		"vault_quota_lease_count": {
+			Resource:       quotaLeaseCountResource(),
+			PathInventory:  []string{"/sys/quotas/lease-count/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_raft_autopilot": {
+			Resource:      raftAutopilotResource(),
+			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
+		},
+		"vault_raft_snapshot_agent_config": {
+			Resource:       raftSnapshotAgentConfigResource(),
+			PathInventory:  []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_rate_limit_quota": {
+			Resource:      rateLimitQuotaResource(),
+			PathInventory: []string{"/sys/quotas/rate-limit/{name}"},
+		},
+		"vault_replication_primary": {
+			Resource:       replicationPrimaryResource(),
+			PathInventory:  []string{"/sys/replication/{mode}/primary/enable"},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_secondary": {
+			Resource:       replicationSecondaryResource(),
+			PathInventory:  []string{"/sys/replication/{mode}/secondary/enable"},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_secondary_token": {
+			Resource:       replicationSecondaryTokenResource(),
+			PathInventory:  []string{"/sys/replication/{mode}/primary/secondary-token"},
+			EnterpriseOnly: true,
+		},
+		"vault_saml_auth_backend": {
+			Resource:       samlAuthBackendResource(),
+			PathInventory:  []string{"/auth/saml/config"},
+			EnterpriseOnly: true,
+		},
+		"vault_saml_auth_backend_role": {
+			Resource:       samlAuthBackendRoleResource(),
+			PathInventory:  []string{"/auth/saml/role/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_sealwrap_rewrap": {
+			Resource:       sealwrapRewrapResource(),
+			PathInventory:  []string{"/sys/sealwrap/rewrap"},
+			EnterpriseOnly: true,
+		},
 		"vault_pki_secret_backend": {
 			Resource:      pkiSecretBackendResource(),
 			PathInventory: []string{UnknownPath},
@@ -532,6 +953,44 @@ var (
 			Resource:      pkiSecretBackendConfigUrlsResource(),
 			PathInventory: []string{"/pki/config/urls"},
 		},
+		"vault_pki_secret_backend_config_auto_tidy": {
+			Resource:      pkiSecretBackendConfigAutoTidyResource(),
+			PathInventory: []string{"/pki/config/auto-tidy"},
+		},
+		"vault_pki_secret_backend_tidy": {
+			Resource:      pkiSecretBackendTidyResource(),
+			PathInventory: []string{"/pki/tidy"},
+		},
+		"vault_pki_secret_backend_config_acme": {
+			Resource:      pkiSecretBackendConfigAcmeResource(),
+			PathInventory: []string{"/pki/config/acme"},
+		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      pkiSecretBackendConfigClusterResource(),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
+		"vault_pki_secret_backend_config_cmp": {
+			Resource:       pkiSecretBackendConfigCmpResource(),
+			PathInventory:  []string{"/pki/config/cmp"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_config_est": {
+			Resource:       pkiSecretBackendConfigEstResource(),
+			PathInventory:  []string{"/pki/config/est"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_config_issuers": {
+			Resource:      pkiSecretBackendConfigIssuersResource(),
+			PathInventory: []string{"/pki/config/issuers"},
+		},
+		"vault_pki_secret_backend_issuer": {
+			Resource:      pkiSecretBackendIssuerResource(),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
+		},
+		"vault_pki_secret_backend_key": {
+			Resource:      pkiSecretBackendKeyResource(),
+			PathInventory: []string{"/pki/key/{key_id}"},
+		},
 		"vault_pki_secret_backend_intermediate_cert_request": {
 			Resource:      pkiSecretBackendIntermediateCertRequestResource(),
 			PathInventory: []string{"/pki/intermediate/generate/{exported}"},
@@ -556,6 +1015,18 @@ var (
 			Resource:      pkiSecretBackendSignResource(),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_terraform_cloud_secret_backend": {
+			Resource:      terraformCloudSecretBackendResource(),
+			PathInventory: []string{"/terraform/config"},
+		},
+		"vault_terraform_cloud_secret_role": {
+			Resource:      terraformCloudSecretRoleResource(),
+			PathInventory: []string{"/terraform/role/{name}"},
+		},
+		"vault_totp_secret_backend_key": {
+			Resource:      totpSecretBackendKeyResource(),
+			PathInventory: []string{"/totp/keys/{name}"},
+		},
 		"vault_transit_secret_backend_key": {
 			Resource:      transitSecretBackendKeyResource(),
 			PathInventory: []string{"/transit/keys/{name}"},
@@ -564,6 +1035,10 @@ var (
 			Resource:      transitSecretBackendCacheConfig(),
 			PathInventory: []string{"/transit/cache-config"},
 		},
+		"vault_userpass_auth_backend_user": {
+			Resource:      userpassAuthBackendUserResource(),
+			PathInventory: []string{"/auth/userpass/users/{username}"},
+		},
 	}
 )
 
@@ -638,7 +1113,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("failed to configure Vault API: %s", err)
 	}
 
+	// Retries are handled inside the Vault API client, which retries 5xx
+	// responses with jittered backoff. The client does not expose its retry
+	// policy, so other transient errors (e.g. 429) cannot be retried here.
 	client.SetMaxRetries(d.Get("max_retries").(int))
+	client.SetClientTimeout(time.Duration(d.Get("client_timeout").(int)) * time.Second)
 
 	// Try an get the token from the config or token helper
 	token, err := providerToken(d)
@@ -0,0 +1,313 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func adSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretBackendCreate,
+		Read:   adSecretBackendRead,
+		Update: adSecretBackendUpdate,
+		Delete: adSecretBackendDelete,
+		Exists: adSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ad",
+				Description: "The mount path for a backend, for example, the path given in \"$ vault auth enable -path=my-ad ad\".",
+				StateFunc: func(s interface{}) string {
+					return strings.Trim(s.(string), "/")
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"binddn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name of object to bind when performing user and group search.",
+			},
+			"bindpass": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "LDAP password for searching for the user DN.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ldap://127.0.0.1",
+				Description: "LDAP URL to connect to (default: ldap://127.0.0.1). Multiple URLs can be specified by concatenating them with commas; they will be tried in-order.",
+			},
+			"userdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN under which to perform user search.",
+			},
+			"upndomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Enables userPrincipalDomain login with [username]@UPNDomain.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying LDAP server certificate, must be x509 PEM encoded.",
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip LDAP server SSL Certificate verification - insecure and not recommended for production use.",
+			},
+			"starttls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issue a StartTLS command after establishing unencrypted connection.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "In seconds, the default password time-to-live.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "In seconds, the maximum password time-to-live.",
+			},
+			"length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The desired length of passwords that Vault generates.",
+			},
+			"formatter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text to insert the password into, ex. \"customPrefix{{PASSWORD}}customSuffix\".",
+			},
+			"last_rotation_tolerance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of seconds after a Vault rotation where, if Active Directory shows a later rotation, it should be considered out-of-band.",
+			},
+		},
+	}
+}
+
+func adSecretBackendConfigData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"binddn":       d.Get("binddn").(string),
+		"bindpass":     d.Get("bindpass").(string),
+		"url":          d.Get("url").(string),
+		"insecure_tls": d.Get("insecure_tls").(bool),
+	}
+	if v, ok := d.GetOk("userdn"); ok {
+		data["userdn"] = v.(string)
+	}
+	if v, ok := d.GetOk("upndomain"); ok {
+		data["upndomain"] = v.(string)
+	}
+	if v, ok := d.GetOk("certificate"); ok {
+		data["certificate"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("starttls"); ok {
+		data["starttls"] = v.(bool)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("length"); ok {
+		data["length"] = v.(int)
+	}
+	if v, ok := d.GetOk("formatter"); ok {
+		data["formatter"] = v.(string)
+	}
+	if v, ok := d.GetOk("last_rotation_tolerance"); ok {
+		data["last_rotation_tolerance"] = v.(int)
+	}
+	return data
+}
+
+func adSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting AD backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "ad",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted AD backend at %q", backend)
+	d.SetId(backend)
+
+	d.SetPartial("backend")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	configPath := adSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Writing AD configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, adSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing AD configuration for %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote AD configuration to %q", configPath)
+	d.Partial(false)
+
+	return adSecretBackendRead(d, meta)
+}
+
+func adSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Reading AD backend mount %q from Vault", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", backend, err)
+	}
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(backend, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := adSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Reading %q from Vault", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading AD configuration from %q: %s", configPath, err)
+	}
+
+	// bindpass is not returned by the API, so drift on it cannot be
+	// detected.
+	if resp != nil {
+		for _, k := range []string{"binddn", "url", "userdn", "upndomain", "certificate", "insecure_tls", "starttls", "ttl", "max_ttl", "length", "formatter", "last_rotation_tolerance"} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func adSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	d.Partial(true)
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		log.Printf("[DEBUG] Updating lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, config); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+	}
+
+	configPath := adSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Updating AD configuration at %q", configPath)
+	if _, err := client.Logical().Write(configPath, adSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing AD configuration for %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Updated AD configuration at %q", configPath)
+	d.Partial(false)
+
+	return adSecretBackendRead(d, meta)
+}
+
+func adSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting AD backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		return fmt.Errorf("error unmounting AD backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted AD backend %q", backend)
+	return nil
+}
+
+func adSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Checking if AD backend exists at %q", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if AD backend exists at %q", backend)
+	_, ok := mounts[strings.Trim(backend, "/")+"/"]
+	return ok, nil
+}
+
+func adSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
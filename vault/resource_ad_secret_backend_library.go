@@ -0,0 +1,198 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	adSecretBackendLibraryBackendFromPathRegex = regexp.MustCompile("^(.+)/library/.+$")
+	adSecretBackendLibraryNameFromPathRegex    = regexp.MustCompile("^.+/library/(.+$)")
+)
+
+func adSecretBackendLibraryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretBackendLibraryWrite,
+		Read:   adSecretBackendLibraryRead,
+		Update: adSecretBackendLibraryWrite,
+		Delete: adSecretBackendLibraryDelete,
+		Exists: adSecretBackendLibraryExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the set of service accounts.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ad",
+				Description: "The mount path for the AD backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"service_account_names": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The names of all the service accounts that can be checked out from this set. These service accounts must already exist in Active Directory.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum amount of time, in seconds, a single check-out lasts before Vault automatically checks it back in.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum amount of time, in seconds, a check-out last with renewal before Vault automatically checks it back in.",
+			},
+			"disable_check_in_enforcement": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable enforcing that service accounts must be checked in by the entity or client token that checked them out.",
+			},
+		},
+	}
+}
+
+func adSecretBackendLibraryWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := adSecretBackendLibraryPath(backend, name)
+
+	data := map[string]interface{}{
+		"service_account_names":        d.Get("service_account_names"),
+		"disable_check_in_enforcement": d.Get("disable_check_in_enforcement").(bool),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing library set %q on AD backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing library set %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote library set %q on AD backend %q", name, backend)
+
+	d.SetId(path)
+	return adSecretBackendLibraryRead(d, meta)
+}
+
+func adSecretBackendLibraryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := adSecretBackendLibraryNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AD library set %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid library set ID %q: %s", path, err)
+	}
+
+	backend, err := adSecretBackendLibraryBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AD library set %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid library set ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading library set from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading library set %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read library set from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Library set %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"service_account_names", "ttl", "max_ttl", "disable_check_in_enforcement"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func adSecretBackendLibraryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting library set %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting library set %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted library set %q", path)
+	return nil
+}
+
+func adSecretBackendLibraryExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func adSecretBackendLibraryPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/library/" + strings.Trim(name, "/")
+}
+
+func adSecretBackendLibraryNameFromPath(path string) (string, error) {
+	if !adSecretBackendLibraryNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := adSecretBackendLibraryNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func adSecretBackendLibraryBackendFromPath(path string) (string, error) {
+	if !adSecretBackendLibraryBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := adSecretBackendLibraryBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccADSecretBackendLibrary_basic(t *testing.T) {
+	url, binddn, bindpass := getTestADCreds(t)
+	serviceAccount := os.Getenv("AD_LIBRARY_SERVICE_ACCOUNT")
+	if serviceAccount == "" {
+		t.Skip("AD_LIBRARY_SERVICE_ACCOUNT not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	name := acctest.RandomWithPrefix("library")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccADSecretBackendLibraryCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretBackendLibraryConfig_basic(backend, url, binddn, bindpass, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_library.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_library.test", "name", name),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_library.test", "service_account_names.0", serviceAccount),
+				),
+			},
+		},
+	})
+}
+
+func testAccADSecretBackendLibraryCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ad_secret_backend_library" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("library %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccADSecretBackendLibraryConfig_basic(backend, url, binddn, bindpass, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  backend = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ad_secret_backend_library" "test" {
+  backend = "${vault_ad_secret_backend.test.backend}"
+  name = "%s"
+  service_account_names = ["%s"]
+  ttl = 60
+  max_ttl = 120
+}
+`, backend, url, binddn, bindpass, name, serviceAccount)
+}
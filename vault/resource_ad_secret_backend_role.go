@@ -0,0 +1,189 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	adSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	adSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+)
+
+func adSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretBackendRoleWrite,
+		Read:   adSecretBackendRoleRead,
+		Update: adSecretBackendRoleWrite,
+		Delete: adSecretBackendRoleDelete,
+		Exists: adSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ad",
+				Description: "The mount path for the AD backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username/logon name for the service account with which this role will be associated.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "In seconds, the default password time-to-live.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last password rotation by Vault.",
+			},
+			"password_last_set": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last password set by Vault.",
+			},
+		},
+	}
+}
+
+func adSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := adSecretBackendRolePath(backend, role)
+
+	data := map[string]interface{}{
+		"service_account_name": d.Get("service_account_name").(string),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing role %q on AD backend %q", role, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on AD backend %q", role, backend)
+
+	d.SetId(path)
+	return adSecretBackendRoleRead(d, meta)
+}
+
+func adSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	role, err := adSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AD role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := adSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AD role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role)
+	d.Set("backend", backend)
+	for _, k := range []string{"service_account_name", "ttl", "last_vault_rotation", "password_last_set"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func adSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func adSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func adSecretBackendRolePath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(role, "/")
+}
+
+func adSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !adSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := adSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func adSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !adSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := adSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
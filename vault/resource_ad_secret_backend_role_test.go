@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccADSecretBackendRole_basic(t *testing.T) {
+	url, binddn, bindpass := getTestADCreds(t)
+	serviceAccount := os.Getenv("AD_SERVICE_ACCOUNT")
+	if serviceAccount == "" {
+		t.Skip("AD_SERVICE_ACCOUNT not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccADSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretBackendRoleConfig_basic(backend, url, binddn, bindpass, name, serviceAccount, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_role.test", "role", name),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_role.test", "service_account_name", serviceAccount),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_role.test", "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccADSecretBackendRoleConfig_basic(backend, url, binddn, bindpass, name, serviceAccount, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend_role.test", "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccADSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ad_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccADSecretBackendRoleConfig_basic(backend, url, binddn, bindpass, name, serviceAccount string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  backend = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ad_secret_backend_role" "test" {
+  backend = "${vault_ad_secret_backend.test.backend}"
+  role = "%s"
+  service_account_name = "%s"
+  ttl = %d
+}
+`, backend, url, binddn, bindpass, name, serviceAccount, ttl)
+}
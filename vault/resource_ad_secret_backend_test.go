@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestADCreds(t *testing.T) (string, string, string) {
+	url := os.Getenv("AD_URL")
+	binddn := os.Getenv("AD_BINDDN")
+	bindpass := os.Getenv("AD_BINDPASS")
+	if url == "" {
+		t.Skip("AD_URL not set")
+	}
+	if binddn == "" {
+		t.Skip("AD_BINDDN not set")
+	}
+	if bindpass == "" {
+		t.Skip("AD_BINDPASS not set")
+	}
+	return url, binddn, bindpass
+}
+
+func TestAccADSecretBackend_basic(t *testing.T) {
+	url, binddn, bindpass := getTestADCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccADSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretBackendConfig_basic(backend, url, binddn, bindpass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "url", url),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "binddn", binddn),
+				),
+			},
+		},
+	})
+}
+
+func testAccADSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ad_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["backend"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccADSecretBackendConfig_basic(backend, url, binddn, bindpass string) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  backend = "%s"
+  description = "test description"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+`, backend, url, binddn, bindpass)
+}
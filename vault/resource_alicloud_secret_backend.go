@@ -0,0 +1,187 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func alicloudSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: alicloudSecretBackendCreate,
+		Read:   alicloudSecretBackendRead,
+		Update: alicloudSecretBackendUpdate,
+		Delete: alicloudSecretBackendDelete,
+		Exists: alicloudSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "alicloud",
+				Description: "Path to mount the backend at.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(string)
+					if strings.HasSuffix(value, "/") {
+						errs = append(errs, fmt.Errorf("path cannot end in '/'"))
+					}
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The AliCloud Access Key ID to use when generating new credentials.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The AliCloud Secret Access Key to use when generating new credentials.",
+			},
+		},
+	}
+}
+
+func alicloudSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting AliCloud backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "alicloud",
+		Description: description,
+		Config:      api.MountConfigInput{},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted AliCloud backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+
+	configPath := alicloudSecretBackendConfigPath(path)
+	data := map[string]interface{}{
+		"access_key": d.Get("access_key").(string),
+		"secret_key": d.Get("secret_key").(string),
+	}
+
+	log.Printf("[DEBUG] Writing AliCloud configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing AliCloud configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AliCloud configuration to %q", configPath)
+	d.Partial(false)
+
+	return alicloudSecretBackendRead(d, meta)
+}
+
+func alicloudSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading AliCloud backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AliCloud backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+
+	log.Printf("[DEBUG] Reading AliCloud secret backend config %q", path)
+	resp, err := client.Logical().Read(alicloudSecretBackendConfigPath(path))
+	if err != nil {
+		return fmt.Errorf("error reading AliCloud secret backend config: %s", err)
+	}
+	if resp != nil {
+		if v, ok := resp.Data["access_key"].(string); ok {
+			d.Set("access_key", v)
+		}
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	if d.HasChange("access_key") || d.HasChange("secret_key") {
+		configPath := alicloudSecretBackendConfigPath(path)
+		data := map[string]interface{}{
+			"access_key": d.Get("access_key").(string),
+			"secret_key": d.Get("secret_key").(string),
+		}
+		log.Printf("[DEBUG] Updating AliCloud configuration at %q", configPath)
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error writing AliCloud configuration for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated AliCloud configuration at %q", configPath)
+	}
+	return alicloudSecretBackendRead(d, meta)
+}
+
+func alicloudSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting AliCloud backend %q", path)
+	err := client.Sys().Unmount(path)
+	if err != nil {
+		return fmt.Errorf("error unmounting AliCloud backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted AliCloud backend %q", path)
+	return nil
+}
+
+func alicloudSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if AliCloud backend exists at %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if AliCloud backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func alicloudSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,210 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/terraform-providers/terraform-provider-vault/util"
+)
+
+var (
+	alicloudSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	alicloudSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+$)")
+)
+
+func alicloudSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: alicloudSecretBackendRoleWrite,
+		Read:   alicloudSecretBackendRoleRead,
+		Update: alicloudSecretBackendRoleWrite,
+		Delete: alicloudSecretBackendRoleDelete,
+		Exists: alicloudSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "alicloud",
+				Description: "The path of the AliCloud Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"remote_policies": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The names and types of pre-existing policies to associate with the generated RAM user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"inline_policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "JSON list of policy documents to attach inline to the generated RAM user.",
+				DiffSuppressFunc: util.JsonDiffSuppress,
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ARN of a role that will be assumed to obtain STS credentials.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The duration in seconds after which the issued credentials should expire.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum allowed lifetime in seconds of credentials issued using this role.",
+			},
+		},
+	}
+}
+
+func alicloudSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := alicloudSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("remote_policies"); ok {
+		data["remote_policies"] = v
+	}
+	if v, ok := d.GetOk("inline_policies"); ok {
+		data["inline_policies"] = v.(string)
+	}
+	if v, ok := d.GetOk("role_arn"); ok {
+		data["role_arn"] = v.(string)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing role %q on AliCloud backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on AliCloud backend %q", name, backend)
+
+	d.SetId(path)
+	return alicloudSecretBackendRoleRead(d, meta)
+}
+
+func alicloudSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := alicloudSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AliCloud role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := alicloudSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AliCloud role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"remote_policies", "inline_policies", "role_arn", "ttl", "max_ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func alicloudSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func alicloudSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func alicloudSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !alicloudSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := alicloudSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func alicloudSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !alicloudSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := alicloudSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAliCloudSecretBackendRole_basic(t *testing.T) {
+	accessKey, secretKey := getTestAliCloudCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAliCloudSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliCloudSecretBackendRoleConfig_basic(backend, accessKey, secretKey, name, "3600"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend_role.test", "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccAliCloudSecretBackendRoleConfig_basic(backend, accessKey, secretKey, name, "1800"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend_role.test", "ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliCloudSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_alicloud_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAliCloudSecretBackendRoleConfig_basic(backend, accessKey, secretKey, name, ttl string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend = "${vault_alicloud_secret_backend.test.path}"
+  name = "%s"
+  remote_policies = ["name:AliyunOSSReadOnlyAccess,type:System"]
+  ttl = %s
+}
+`, backend, accessKey, secretKey, name, ttl)
+}
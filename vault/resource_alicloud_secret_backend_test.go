@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestAliCloudCreds(t *testing.T) (string, string) {
+	accessKey := os.Getenv("ALICLOUD_ACCESS_KEY")
+	secretKey := os.Getenv("ALICLOUD_SECRET_KEY")
+	if accessKey == "" {
+		t.Skip("ALICLOUD_ACCESS_KEY not set")
+	}
+	if secretKey == "" {
+		t.Skip("ALICLOUD_SECRET_KEY not set")
+	}
+	return accessKey, secretKey
+}
+
+func TestAccAliCloudSecretBackend_basic(t *testing.T) {
+	accessKey, secretKey := getTestAliCloudCreds(t)
+	path := acctest.RandomWithPrefix("tf-test-alicloud")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAliCloudSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliCloudSecretBackendConfig_basic(path, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_alicloud_secret_backend.test", "description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliCloudSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_alicloud_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccAliCloudSecretBackendConfig_basic(path, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  access_key = "%s"
+  secret_key = "%s"
+}
+`, path, accessKey, secretKey)
+}
@@ -30,6 +30,7 @@ func approleAuthBackendLoginResource() *schema.Resource {
 				Optional:    true,
 				Description: "The SecretID to log in with.",
 				ForceNew:    true,
+				Sensitive:   true,
 			},
 			"policies": {
 				Type:     schema.TypeList,
@@ -161,7 +162,7 @@ func approleAuthBackendLoginDelete(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Revoking token %q", accessor)
 	err := client.Auth().Token().RevokeAccessor(accessor)
 	if err != nil {
-		return fmt.Errorf("error revoking token %q", accessor)
+		return fmt.Errorf("error revoking token %q: %s", accessor, err)
 	}
 	log.Printf("[DEBUG] Revoked token %q", accessor)
 
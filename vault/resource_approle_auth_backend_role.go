@@ -334,7 +334,7 @@ func approleAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Deleting AppRole auth backend role %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil && !util.Is404(err) {
-		return fmt.Errorf("error deleting AppRole auth backend role %q", path)
+		return fmt.Errorf("error deleting AppRole auth backend role %q: %s", path, err)
 	} else if err != nil {
 		log.Printf("[DEBUG] AppRole auth backend role %q not found, removing from state", path)
 		d.SetId("")
@@ -41,6 +41,14 @@ func auditResource() *schema.Resource {
 				Description: "Human-friendly description of the audit device",
 			},
 
+			"local": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Specifies if the audit device is a local only. Local audit devices are not replicated nor (if a secondary) removed by replication.",
+			},
+
 			"options": {
 				Type:        schema.TypeMap,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -69,12 +77,12 @@ func auditWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Enabling audit backend %s in Vault", path)
 
-	if err := client.Sys().EnableAudit(
-		path,
-		d.Get("type").(string),
-		d.Get("description").(string),
-		options,
-	); err != nil {
+	if err := client.Sys().EnableAuditWithOptions(path, &api.EnableAuditOptions{
+		Type:        d.Get("type").(string),
+		Description: d.Get("description").(string),
+		Options:     options,
+		Local:       d.Get("local").(bool),
+	}); err != nil {
 		return fmt.Errorf("error enabling audit backend: %s", err)
 	}
 
@@ -104,8 +112,6 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading audit backends %s from Vault", path)
 
-	client.Sys().ListAudit()
-
 	audits, err := client.Sys().ListAudit()
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
@@ -124,6 +130,7 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("path", path)
 	d.Set("type", audit.Type)
 	d.Set("description", audit.Description)
+	d.Set("local", audit.Local)
 	d.Set("options", audit.Options)
 
 	return nil
@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const auditRequestHeaderPath = "sys/config/auditing/request-headers/"
+
+func auditRequestHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: auditRequestHeaderCreate,
+		Update: auditRequestHeaderUpdate,
+		Read:   auditRequestHeaderRead,
+		Delete: auditRequestHeaderDelete,
+		Exists: auditRequestHeaderExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the request header to audit.",
+			},
+			"hmac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this header's value should be HMAC'd in the audit logs.",
+			},
+		},
+	}
+}
+
+func auditRequestHeaderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := auditRequestHeaderPath + name
+
+	data := map[string]interface{}{
+		"hmac": d.Get("hmac").(bool),
+	}
+
+	log.Printf("[DEBUG] Creating audited request header %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating audited request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Created audited request header %q", name)
+
+	d.SetId(name)
+
+	return auditRequestHeaderRead(d, meta)
+}
+
+func auditRequestHeaderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := auditRequestHeaderPath + name
+
+	data := map[string]interface{}{
+		"hmac": d.Get("hmac").(bool),
+	}
+
+	log.Printf("[DEBUG] Updating audited request header %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating audited request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated audited request header %q", name)
+
+	return auditRequestHeaderRead(d, meta)
+}
+
+func auditRequestHeaderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := auditRequestHeaderPath + name
+
+	log.Printf("[DEBUG] Reading audited request header %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading audited request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read audited request header %q", name)
+
+	if resp == nil {
+		log.Printf("[WARN] Audited request header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+
+	// The response is keyed by the header name itself.
+	if header, ok := resp.Data[name].(map[string]interface{}); ok {
+		d.Set("hmac", header["hmac"])
+	}
+
+	return nil
+}
+
+func auditRequestHeaderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := auditRequestHeaderPath + name
+
+	log.Printf("[DEBUG] Deleting audited request header %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting audited request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted audited request header %q", name)
+
+	return nil
+}
+
+func auditRequestHeaderExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := auditRequestHeaderPath + name
+
+	log.Printf("[DEBUG] Checking if audited request header %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if audited request header %q exists: %s", name, err)
+	}
+	log.Printf("[DEBUG] Checked if audited request header %q exists", name)
+
+	return resp != nil, nil
+}
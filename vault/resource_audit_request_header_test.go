@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAuditRequestHeader_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAuditRequestHeaderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditRequestHeaderConfig_basic("X-Custom-Header", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_audit_request_header.test", "name", "X-Custom-Header"),
+					resource.TestCheckResourceAttr("vault_audit_request_header.test", "hmac", "false"),
+				),
+			},
+			{
+				Config: testAccAuditRequestHeaderConfig_basic("X-Custom-Header", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_audit_request_header.test", "hmac", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditRequestHeaderCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_audit_request_header" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/config/auditing/request-headers/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("audit request header %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAuditRequestHeaderConfig_basic(name string, hmac bool) string {
+	return fmt.Sprintf(`
+resource "vault_audit_request_header" "test" {
+  name = "%s"
+  hmac = %t
+}
+`, name, hmac)
+}
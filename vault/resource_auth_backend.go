@@ -190,7 +190,7 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			err := authMountTune(client, "auth/"+path, raw)
 			if err != nil {
-				return nil
+				return fmt.Errorf("error tuning auth mount %q: %s", path, err)
 			}
 
 			log.Printf("[INFO] Written %s auth tune to '%q'", backendType, path)
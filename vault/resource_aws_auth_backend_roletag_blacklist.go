@@ -68,7 +68,7 @@ func awsAuthBackendRoleTagBlacklistWrite(d *schema.ResourceData, meta interface{
 
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error configuring AWS auth backend roletag blacklist %q: %s", path, err)
+		return fmt.Errorf("error configuring AWS auth backend roletag blacklist %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Configured AWS backend roletag blacklist %q", path)
 
@@ -86,13 +86,13 @@ func awsAuthBackendRoleTagBlacklistRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		log.Printf("[WARN] Removing invalid ID %q from state", d.Id())
 		d.SetId("")
-		return fmt.Errorf("Invalid path %q for AWS auth backend roletag blacklist: %s", path, err)
+		return fmt.Errorf("invalid path %q for AWS auth backend roletag blacklist: %s", path, err)
 	}
 
 	log.Printf("[DEBUG] Reading roletag blacklist %q from AWS auth backend", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
-		return fmt.Errorf("Error reading AWS auth backend roletag blacklist %q: %s", path, err)
+		return fmt.Errorf("error reading AWS auth backend roletag blacklist %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Read roletag blacklist %q from AWS auth backend", path)
 	if resp == nil {
@@ -115,7 +115,7 @@ func awsAuthBackendRoleTagBlacklistDelete(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Removing roletag blacklist %q from AWS auth backend", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("Error deleting AWS auth backend roletag blacklist %q: %s", path, err)
+		return fmt.Errorf("error deleting AWS auth backend roletag blacklist %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Removed roletag blacklist %q from AWS auth backend", path)
 
@@ -130,7 +130,7 @@ func awsAuthBackendRoleTagBlacklistExists(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Checking if roletag blacklist %q exists in AWS auth backend", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
-		return true, fmt.Errorf("Error checking for existence of AWS auth backend roletag blacklist %q: %s", path, err)
+		return true, fmt.Errorf("error checking for existence of AWS auth backend roletag blacklist %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Checked if roletag blacklist %q exists in AWS auth backend", path)
 	return resp != nil, nil
@@ -95,7 +95,7 @@ func awsAuthBackendSTSRoleRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Reading STS role %q from AWS auth backend", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
-		return fmt.Errorf("error reading STS role %q from AWS auth backend %s", path, err)
+		return fmt.Errorf("error reading STS role %q from AWS auth backend: %s", path, err)
 	}
 	log.Printf("[DEBUG] Read STS role %q from AWS auth backend", path)
 	if resp == nil {
@@ -75,6 +75,16 @@ func awsSecretBackendResource() *schema.Resource {
 				Computed:    true,
 				Description: "The AWS region to make API calls against. Defaults to us-east-1.",
 			},
+			"iam_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a custom HTTP IAM endpoint to use.",
+			},
+			"sts_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a custom HTTP STS endpoint to use.",
+			},
 		},
 	}
 }
@@ -119,6 +129,12 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if region != "" {
 		data["region"] = region
 	}
+	if v, ok := d.GetOk("iam_endpoint"); ok {
+		data["iam_endpoint"] = v.(string)
+	}
+	if v, ok := d.GetOk("sts_endpoint"); ok {
+		data["sts_endpoint"] = v.(string)
+	}
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -126,6 +142,8 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Wrote root credentials to %q", path+"/config/root")
 	d.SetPartial("access_key")
 	d.SetPartial("secret_key")
+	d.SetPartial("iam_endpoint")
+	d.SetPartial("sts_endpoint")
 	if region == "" {
 		d.Set("region", "us-east-1")
 	}
@@ -185,6 +203,12 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			d.Set("region", "us-east-1")
 		}
+		if v, ok := resp.Data["iam_endpoint"].(string); ok {
+			d.Set("iam_endpoint", v)
+		}
+		if v, ok := resp.Data["sts_endpoint"].(string); ok {
+			d.Set("sts_endpoint", v)
+		}
 	}
 
 	d.Set("path", path)
@@ -214,7 +238,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") {
+	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -224,6 +248,12 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if region != "" {
 			data["region"] = region
 		}
+		if v, ok := d.GetOk("iam_endpoint"); ok {
+			data["iam_endpoint"] = v.(string)
+		}
+		if v, ok := d.GetOk("sts_endpoint"); ok {
+			data["sts_endpoint"] = v.(string)
+		}
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -231,6 +261,8 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated root credentials at %q", path+"/config/root")
 		d.SetPartial("access_key")
 		d.SetPartial("secret_key")
+		d.SetPartial("iam_endpoint")
+		d.SetPartial("sts_endpoint")
 		if region == "" {
 			d.Set("region", "us-east-1")
 		}
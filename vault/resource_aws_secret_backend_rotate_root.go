@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func awsSecretBackendRotateRootResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsSecretBackendRotateRootCreate,
+		Read:   awsSecretBackendRotateRootRead,
+		Delete: awsSecretBackendRotateRootDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the AWS Secret Backend whose root credentials should be rotated.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value that, when changed, triggers another rotation of the root credentials.",
+			},
+		},
+	}
+}
+
+func awsSecretBackendRotateRootCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := strings.Trim(backend, "/") + "/config/rotate-root"
+
+	log.Printf("[DEBUG] Rotating root credentials on AWS backend %q", backend)
+	_, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return fmt.Errorf("error rotating root credentials on AWS backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials on AWS backend %q", backend)
+
+	d.SetId(path)
+	return nil
+}
+
+func awsSecretBackendRotateRootRead(d *schema.ResourceData, meta interface{}) error {
+	// The rotate-root endpoint is write-only; there is nothing to read back.
+	return nil
+}
+
+func awsSecretBackendRotateRootDelete(d *schema.ResourceData, meta interface{}) error {
+	// Rotation cannot be undone; removing the resource only removes it from state.
+	return nil
+}
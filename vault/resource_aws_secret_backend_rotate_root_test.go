@@ -0,0 +1,48 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAWSSecretBackendRotateRoot_basic(t *testing.T) {
+	// Rotation invalidates the supplied secret key, so this test requires
+	// credentials dedicated to rotation testing.
+	accessKey := os.Getenv("AWS_ROTATE_ACCESS_KEY_ID")
+	secretKey := os.Getenv("AWS_ROTATE_SECRET_ACCESS_KEY")
+	if accessKey == "" || secretKey == "" {
+		t.Skip("AWS_ROTATE_ACCESS_KEY_ID and AWS_ROTATE_SECRET_ACCESS_KEY must be set")
+	}
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRotateRootConfig_basic(path, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_rotate_root.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_rotate_root.test", "id", path+"/config/rotate-root"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecretBackendRotateRootConfig_basic(path, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_rotate_root" "test" {
+  backend = "${vault_aws_secret_backend.test.path}"
+}
+`, path, accessKey, secretKey)
+}
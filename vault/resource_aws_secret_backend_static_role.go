@@ -0,0 +1,174 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	awsSecretBackendStaticRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/static-roles/.+$")
+	awsSecretBackendStaticRoleNameFromPathRegex    = regexp.MustCompile("^.+/static-roles/(.+$)")
+)
+
+func awsSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsSecretBackendStaticRoleWrite,
+		Read:   awsSecretBackendStaticRoleRead,
+		Update: awsSecretBackendStaticRoleWrite,
+		Delete: awsSecretBackendStaticRoleDelete,
+		Exists: awsSecretBackendStaticRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the static role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "aws",
+				Description: "The path of the AWS Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing AWS IAM user to manage password rotation for.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "How often Vault should rotate the password of the user entry, in seconds.",
+			},
+		},
+	}
+}
+
+func awsSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := awsSecretBackendStaticRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+
+	log.Printf("[DEBUG] Creating static role %q on AWS backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating static role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Created static role %q on AWS backend %q", name, backend)
+
+	d.SetId(path)
+	return awsSecretBackendStaticRoleRead(d, meta)
+}
+
+func awsSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := awsSecretBackendStaticRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AWS static role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	backend, err := awsSecretBackendStaticRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing AWS static role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading static role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read static role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	d.Set("username", secret.Data["username"])
+	d.Set("rotation_period", secret.Data["rotation_period"])
+
+	return nil
+}
+
+func awsSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting static role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted static role %q", path)
+	return nil
+}
+
+func awsSecretBackendStaticRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func awsSecretBackendStaticRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(name, "/")
+}
+
+func awsSecretBackendStaticRoleNameFromPath(path string) (string, error) {
+	if !awsSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := awsSecretBackendStaticRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func awsSecretBackendStaticRoleBackendFromPath(path string) (string, error) {
+	if !awsSecretBackendStaticRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsSecretBackendStaticRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
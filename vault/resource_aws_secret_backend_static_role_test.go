@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAWSSecretBackendStaticRole_basic(t *testing.T) {
+	accessKey, secretKey := getTestAWSCreds(t)
+	username := os.Getenv("AWS_STATIC_USER")
+	if username == "" {
+		t.Skip("AWS_STATIC_USER not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendStaticRoleConfig_basic(backend, accessKey, secretKey, name, username, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_static_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_static_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_static_role.test", "username", username),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_static_role.test", "rotation_period", "3600"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendStaticRoleConfig_basic(backend, accessKey, secretKey, name, username, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_static_role.test", "rotation_period", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_aws_secret_backend_static_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_aws_secret_backend_static_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("static role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSSecretBackendStaticRoleConfig_basic(backend, accessKey, secretKey, name, username string, rotationPeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_static_role" "test" {
+  backend = "${vault_aws_secret_backend.test.path}"
+  name = "%s"
+  username = "%s"
+  rotation_period = %d
+}
+`, backend, accessKey, secretKey, name, username, rotationPeriod)
+}
@@ -414,7 +414,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Updating role %q in Azure auth backend", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
-		return fmt.Errorf("Error updating Azure auth role %q: %s", path, err)
+		return fmt.Errorf("error updating Azure auth role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Updated role %q to Azure auth backend", path)
 
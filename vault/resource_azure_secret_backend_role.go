@@ -66,11 +66,40 @@ func azureSecretBackendRoleResource() *schema.Resource {
 					},
 				},
 			},
+			"azure_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"application_object_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals.",
 			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the applications and service principals created by Vault will be permanently deleted when the corresponding leases expire.",
+			},
+			"sign_in_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies the security principal types that are allowed to sign in to the application. Valid values are: AzureADMyOrg, AzureADMultipleOrgs, AzureADandPersonalMicrosoftAccount, PersonalMicrosoftAccount.",
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -99,10 +128,28 @@ func azureSecretBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 		data["azure_roles"] = string(jsonAzureListString)
 	}
 
+	if v, ok := d.GetOk("azure_groups"); ok {
+		rawGroupList := v.(*schema.Set).List()
+
+		jsonGroupList, _ := json.Marshal(rawGroupList)
+		jsonGroupListString := string(jsonGroupList)
+
+		log.Printf("[DEBUG] Azure GroupSet turned to escaped JSON: %s", jsonGroupListString)
+		data["azure_groups"] = jsonGroupListString
+	}
+
 	if v, ok := d.GetOk("application_object_id"); ok {
 		data["application_object_id"] = v.(string)
 	}
 
+	if v, ok := d.GetOkExists("permanently_delete"); ok {
+		data["permanently_delete"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("sign_in_audience"); ok {
+		data["sign_in_audience"] = v.(string)
+	}
+
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = v.(string)
 	}
@@ -174,6 +221,8 @@ func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		"ttl",
 		"max_ttl",
 		"application_object_id",
+		"permanently_delete",
+		"sign_in_audience",
 	} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
@@ -188,6 +237,12 @@ func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("azure_roles", resp.Data["azure_roles"])
 	}
 
+	if v, ok := resp.Data["azure_groups"]; ok {
+		log.Printf("[DEBUG] Group Data from Azure: %s", v)
+
+		d.Set("azure_groups", resp.Data["azure_groups"])
+	}
+
 	return nil
 }
 
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAzureSecretBackendRotateRoot_basic(t *testing.T) {
+	// Rotation invalidates the supplied client secret, so this test requires
+	// a service principal dedicated to rotation testing.
+	if os.Getenv("AZURE_ROTATE_ROOT") == "" {
+		t.Skip("AZURE_ROTATE_ROOT not set")
+	}
+	conf := getTestAzureConf(t)
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureSecretBackendRotateRootConfig_basic(path, conf),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_rotate_root.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_rotate_root.test", "id", path+"/rotate-root"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureSecretBackendRotateRootConfig_basic(path string, conf *azureTestConf) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path = "%s"
+  subscription_id = "%s"
+  tenant_id = "%s"
+  client_id = "%s"
+  client_secret = "%s"
+}
+
+resource "vault_azure_secret_backend_rotate_root" "test" {
+  backend = "${vault_azure_secret_backend.test.path}"
+}
+`, path, conf.SubscriptionID, conf.TenantID, conf.ClientID, conf.ClientSecret)
+}
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -10,6 +11,11 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	certCertResourceBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/certs/.+$")
+	certCertResourceNameFromPathRegex    = regexp.MustCompile("^auth/.+/certs/(.+)$")
+)
+
 func certAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"name": {
@@ -78,6 +84,33 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"ocsp_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"ocsp_ca_certificates": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ocsp_servers_override": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+			Computed: true,
+		},
+		"ocsp_fail_open": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"ocsp_query_all_servers": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
 		"display_name": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -152,6 +185,9 @@ func certAuthBackendRoleResource() *schema.Resource {
 		Update: certAuthResourceUpdate,
 		Read:   certAuthResourceRead,
 		Delete: certAuthResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: fields,
 	}
@@ -161,6 +197,28 @@ func certCertResourcePath(backend, name string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/certs/" + strings.Trim(name, "/")
 }
 
+func certCertResourceBackendFromPath(path string) (string, error) {
+	if !certCertResourceBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := certCertResourceBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func certCertResourceNameFromPath(path string) (string, error) {
+	if !certCertResourceNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := certCertResourceNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
 func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -186,6 +244,10 @@ func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 		data["allowed_dns_sans"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("allowed_email_sans"); ok {
+		data["allowed_email_sans"] = v.(*schema.Set).List()
+	}
+
 	if v, ok := d.GetOk("allowed_uri_sans"); ok {
 		data["allowed_uri_sans"] = v.(*schema.Set).List()
 	}
@@ -198,6 +260,26 @@ func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 		data["required_extensions"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOkExists("ocsp_enabled"); ok {
+		data["ocsp_enabled"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("ocsp_ca_certificates"); ok {
+		data["ocsp_ca_certificates"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("ocsp_servers_override"); ok {
+		data["ocsp_servers_override"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOkExists("ocsp_fail_open"); ok {
+		data["ocsp_fail_open"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ocsp_query_all_servers"); ok {
+		data["ocsp_query_all_servers"] = v.(bool)
+	}
+
 	if v, ok := d.GetOk("display_name"); ok {
 		data["display_name"] = v.(string)
 	}
@@ -256,6 +338,10 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["allowed_dns_sans"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("allowed_email_sans"); ok {
+		data["allowed_email_sans"] = v.(*schema.Set).List()
+	}
+
 	if v, ok := d.GetOk("allowed_uri_sans"); ok {
 		data["allowed_uri_sans"] = v.(*schema.Set).List()
 	}
@@ -268,6 +354,26 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["required_extensions"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOkExists("ocsp_enabled"); ok {
+		data["ocsp_enabled"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("ocsp_ca_certificates"); ok {
+		data["ocsp_ca_certificates"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("ocsp_servers_override"); ok {
+		data["ocsp_servers_override"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOkExists("ocsp_fail_open"); ok {
+		data["ocsp_fail_open"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ocsp_query_all_servers"); ok {
+		data["ocsp_query_all_servers"] = v.(bool)
+	}
+
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = v.(string)
 	}
@@ -306,6 +412,16 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	backend, err := certCertResourceBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for cert auth backend role: %s", path, err)
+	}
+
+	name, err := certCertResourceNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for cert auth backend role: %s", path, err)
+	}
+
 	log.Printf("[DEBUG] Reading cert %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
@@ -319,6 +435,9 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("backend", backend)
+	d.Set("name", name)
+
 	readTokenFields(d, resp)
 
 	// Check if the user is using the deprecated `policies`
@@ -389,6 +508,21 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("display_name", resp.Data["display_name"])
+	d.Set("ocsp_enabled", resp.Data["ocsp_enabled"])
+	d.Set("ocsp_ca_certificates", resp.Data["ocsp_ca_certificates"])
+	d.Set("ocsp_fail_open", resp.Data["ocsp_fail_open"])
+	d.Set("ocsp_query_all_servers", resp.Data["ocsp_query_all_servers"])
+
+	// Vault sometimes returns these as null instead of an empty list.
+	if resp.Data["ocsp_servers_override"] != nil {
+		d.Set("ocsp_servers_override",
+			schema.NewSet(
+				schema.HashString, resp.Data["ocsp_servers_override"].([]interface{})))
+	} else {
+		d.Set("ocsp_servers_override",
+			schema.NewSet(
+				schema.HashString, []interface{}{}))
+	}
 
 	// Vault sometimes returns these as null instead of an empty list.
 	if resp.Data["allowed_names"] != nil {
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const configControlGroupPath = "sys/config/control-group"
+
+func configControlGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Create: configControlGroupWrite,
+		Update: configControlGroupWrite,
+		Read:   configControlGroupRead,
+		Delete: configControlGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_ttl": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The maximum time a control group token is valid, after which an authorized request is expired and must be re-requested.",
+			},
+		},
+	}
+}
+
+func configControlGroupWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"max_ttl": d.Get("max_ttl").(string),
+	}
+
+	log.Printf("[DEBUG] Writing control group config %q", configControlGroupPath)
+	_, err := client.Logical().Write(configControlGroupPath, data)
+	if err != nil {
+		return fmt.Errorf("error writing control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote control group config %q", configControlGroupPath)
+
+	d.SetId(configControlGroupPath)
+
+	return configControlGroupRead(d, meta)
+}
+
+func configControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading control group config %q", configControlGroupPath)
+	resp, err := client.Logical().Read(configControlGroupPath)
+	if err != nil {
+		return fmt.Errorf("error reading control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Read control group config %q", configControlGroupPath)
+
+	if resp == nil {
+		log.Printf("[WARN] Control group config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	// max_ttl is returned in seconds.
+	d.Set("max_ttl", fmt.Sprintf("%v", resp.Data["max_ttl"]))
+
+	return nil
+}
+
+func configControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Deleting control group config %q", configControlGroupPath)
+	_, err := client.Logical().Delete(configControlGroupPath)
+	if err != nil {
+		return fmt.Errorf("error deleting control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Deleted control group config %q", configControlGroupPath)
+
+	return nil
+}
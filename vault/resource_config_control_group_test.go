@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccConfigControlGroup_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigControlGroupConfig_basic("4h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_control_group.test", "max_ttl", "4h"),
+				),
+			},
+			{
+				Config: testAccConfigControlGroupConfig_basic("8h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_control_group.test", "max_ttl", "8h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigControlGroupConfig_basic(maxTTL string) string {
+	return fmt.Sprintf(`
+resource "vault_config_control_group" "test" {
+  max_ttl = "%s"
+}
+`, maxTTL)
+}
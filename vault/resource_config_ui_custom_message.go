@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const configUICustomMessagesPath = "sys/config/ui/custom-messages"
+
+func configUICustomMessageResource() *schema.Resource {
+	return &schema.Resource{
+		Create: configUICustomMessageCreate,
+		Update: configUICustomMessageUpdate,
+		Read:   configUICustomMessageRead,
+		Delete: configUICustomMessageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the custom message.",
+			},
+			"message_base64": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The base64-encoded content of the custom message.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The starting time of the active period of the custom message, in RFC3339 format.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ending time of the active period of the custom message, in RFC3339 format. Can be omitted for non-expiring messages.",
+			},
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the custom message is displayed pre-login or post-login.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "banner",
+				Description:  "The display type of custom message; either \"banner\" or \"modal\".",
+				ValidateFunc: validation.StringInSlice([]string{"banner", "modal"}, false),
+			},
+		},
+	}
+}
+
+func configUICustomMessageData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"title":         d.Get("title").(string),
+		"message":       d.Get("message_base64").(string),
+		"start_time":    d.Get("start_time").(string),
+		"authenticated": d.Get("authenticated").(bool),
+		"type":          d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		data["end_time"] = v.(string)
+	}
+	return data
+}
+
+func configUICustomMessageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Creating custom message %q", d.Get("title").(string))
+	resp, err := client.Logical().Write(configUICustomMessagesPath, configUICustomMessageData(d))
+	if err != nil {
+		return fmt.Errorf("error creating custom message: %s", err)
+	}
+
+	if resp == nil || resp.Data["id"] == nil {
+		return fmt.Errorf("no ID returned when creating custom message")
+	}
+	id := resp.Data["id"].(string)
+	log.Printf("[DEBUG] Created custom message %q", id)
+
+	d.SetId(id)
+
+	return configUICustomMessageRead(d, meta)
+}
+
+func configUICustomMessageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Updating custom message %q", id)
+	_, err := client.Logical().Write(configUICustomMessagesPath+"/"+id, configUICustomMessageData(d))
+	if err != nil {
+		return fmt.Errorf("error updating custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated custom message %q", id)
+
+	return configUICustomMessageRead(d, meta)
+}
+
+func configUICustomMessageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Reading custom message %q", id)
+	resp, err := client.Logical().Read(configUICustomMessagesPath + "/" + id)
+	if err != nil {
+		return fmt.Errorf("error reading custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read custom message %q", id)
+
+	if resp == nil {
+		log.Printf("[WARN] Custom message %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("title", resp.Data["title"])
+	d.Set("message_base64", resp.Data["message"])
+	d.Set("start_time", resp.Data["start_time"])
+	if v, ok := resp.Data["end_time"]; ok && v != nil {
+		d.Set("end_time", v)
+	}
+	d.Set("authenticated", resp.Data["authenticated"])
+	d.Set("type", resp.Data["type"])
+
+	return nil
+}
+
+func configUICustomMessageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Deleting custom message %q", id)
+	_, err := client.Logical().Delete(configUICustomMessagesPath + "/" + id)
+	if err != nil {
+		return fmt.Errorf("error deleting custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted custom message %q", id)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccConfigUICustomMessage_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	message := base64.StdEncoding.EncodeToString([]byte("Scheduled maintenance at midnight"))
+	updated := base64.StdEncoding.EncodeToString([]byte("Maintenance rescheduled to noon"))
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConfigUICustomMessageCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigUICustomMessageConfig_basic("Maintenance", message),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_ui_custom_message.test", "title", "Maintenance"),
+					resource.TestCheckResourceAttr("vault_config_ui_custom_message.test", "message_base64", message),
+					resource.TestCheckResourceAttr("vault_config_ui_custom_message.test", "type", "banner"),
+				),
+			},
+			{
+				Config: testAccConfigUICustomMessageConfig_basic("Maintenance", updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_ui_custom_message.test", "message_base64", updated),
+				),
+			},
+			{
+				ResourceName:      "vault_config_ui_custom_message.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigUICustomMessageCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_config_ui_custom_message" {
+			continue
+		}
+		secret, err := client.Logical().Read(configUICustomMessagesPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("custom message %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConfigUICustomMessageConfig_basic(title, messageBase64 string) string {
+	return fmt.Sprintf(`
+resource "vault_config_ui_custom_message" "test" {
+  title = "%s"
+  message_base64 = "%s"
+  start_time = "2026-01-01T00:00:00Z"
+}
+`, title, messageBase64)
+}
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const configUIHeadersPath = "sys/config/ui/headers/"
+
+func configUIHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: configUIHeaderWrite,
+		Update: configUIHeaderWrite,
+		Read:   configUIHeaderRead,
+		Delete: configUIHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the header to be served by the Vault UI.",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The values of the header.",
+			},
+		},
+	}
+}
+
+func configUIHeaderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := configUIHeadersPath + name
+
+	data := map[string]interface{}{
+		"values":     expandStringSlice(d.Get("values").([]interface{})),
+		"multivalue": true,
+	}
+
+	log.Printf("[DEBUG] Writing UI header %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote UI header %q", name)
+
+	d.SetId(name)
+
+	return configUIHeaderRead(d, meta)
+}
+
+func configUIHeaderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := configUIHeadersPath + name
+
+	log.Printf("[DEBUG] Reading UI header %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read UI header %q", name)
+
+	if resp == nil {
+		log.Printf("[WARN] UI header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	if v, ok := resp.Data["values"]; ok {
+		d.Set("values", v)
+	} else if v, ok := resp.Data["value"]; ok {
+		// older servers only return the first value.
+		d.Set("values", []interface{}{v})
+	}
+
+	return nil
+}
+
+func configUIHeaderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := configUIHeadersPath + name
+
+	log.Printf("[DEBUG] Deleting UI header %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted UI header %q", name)
+
+	return nil
+}
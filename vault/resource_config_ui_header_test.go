@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccConfigUIHeader_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConfigUIHeaderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigUIHeaderConfig_basic("X-Custom-Header", "custom-value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_ui_header.test", "name", "X-Custom-Header"),
+					resource.TestCheckResourceAttr("vault_config_ui_header.test", "values.0", "custom-value"),
+				),
+			},
+			{
+				Config: testAccConfigUIHeaderConfig_basic("X-Custom-Header", "updated-value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_config_ui_header.test", "values.0", "updated-value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigUIHeaderCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_config_ui_header" {
+			continue
+		}
+		secret, err := client.Logical().Read(configUIHeadersPath + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("UI header %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConfigUIHeaderConfig_basic(name, value string) string {
+	return fmt.Sprintf(`
+resource "vault_config_ui_header" "test" {
+  name = "%s"
+  values = ["%s"]
+}
+`, name, value)
+}
@@ -65,10 +65,33 @@ func consulSecretBackendResource() *schema.Resource {
 			},
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Specifies the Consul ACL token to use. This must be a management type token.",
 				Sensitive:   true,
 			},
+			"bootstrap": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Denotes a backend resource that is used to bootstrap the Consul ACL system. Only one resource may be used to bootstrap.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying Consul server certificate, must be x509 PEM encoded.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client certificate used for Consul's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_key.",
+				Sensitive:   true,
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client key used for Consul's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_cert.",
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -80,6 +103,17 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	address := d.Get("address").(string)
 	scheme := d.Get("scheme").(string)
 	token := d.Get("token").(string)
+	bootstrap := d.Get("bootstrap").(bool)
+
+	if token == "" && !bootstrap {
+		return fmt.Errorf("field 'bootstrap' must be set to true when 'token' is unspecified")
+	} else if token != "" && bootstrap {
+		return fmt.Errorf("field 'bootstrap' must be set to false when 'token' is specified")
+	}
+
+	if (d.Get("client_cert").(string) == "") != (d.Get("client_key").(string) == "") {
+		return fmt.Errorf("fields 'client_cert' and 'client_key' must be specified together")
+	}
 
 	configPath := consulSecretBackendConfigPath(path)
 
@@ -113,6 +147,15 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 		"token":   token,
 		"scheme":  scheme,
 	}
+	if v, ok := d.GetOk("ca_cert"); ok {
+		data["ca_cert"] = v.(string)
+	}
+	if v, ok := d.GetOk("client_cert"); ok {
+		data["client_cert"] = v.(string)
+	}
+	if v, ok := d.GetOk("client_key"); ok {
+		data["client_key"] = v.(string)
+	}
 	if _, err := client.Logical().Write(configPath, data); err != nil {
 		return fmt.Errorf("Error writing Consul configuration for %q: %s", path, err)
 	}
@@ -120,6 +163,9 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("address")
 	d.SetPartial("token")
 	d.SetPartial("scheme")
+	d.SetPartial("ca_cert")
+	d.SetPartial("client_cert")
+	d.SetPartial("client_key")
 	d.Partial(false)
 
 	return nil
@@ -192,13 +238,22 @@ func consulSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("address") || d.HasChange("token") || d.HasChange("scheme") {
+	if d.HasChange("address") || d.HasChange("token") || d.HasChange("scheme") || d.HasChange("ca_cert") || d.HasChange("client_cert") || d.HasChange("client_key") {
 		log.Printf("[DEBUG] Updating Consul configuration at %q", configPath)
 		data := map[string]interface{}{
 			"address": d.Get("address").(string),
 			"token":   d.Get("token").(string),
 			"scheme":  d.Get("scheme").(string),
 		}
+		if v, ok := d.GetOk("ca_cert"); ok {
+			data["ca_cert"] = v.(string)
+		}
+		if v, ok := d.GetOk("client_cert"); ok {
+			data["client_cert"] = v.(string)
+		}
+		if v, ok := d.GetOk("client_key"); ok {
+			data["client_key"] = v.(string)
+		}
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("Error configuring Consul configuration for %q: %s", path, err)
 		}
@@ -206,6 +261,9 @@ func consulSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("address")
 		d.SetPartial("token")
 		d.SetPartial("scheme")
+		d.SetPartial("ca_cert")
+		d.SetPartial("client_cert")
+		d.SetPartial("client_key")
 	}
 	d.Partial(false)
 	return consulSecretBackendRead(d, meta)
@@ -49,12 +49,48 @@ func consulSecretBackendRoleResource() *schema.Resource {
 			},
 			"policies": {
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
 				Description: "List of Consul policies to associate with this role",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"consul_policies": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of Consul ACL policies to associate with these roles.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"node_identities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Consul node identities to attach to the token. Each entry is of the form \"node-name:datacenter\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_identities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Consul service identities to attach to the token. Each entry is of the form \"service-name:datacenter1,datacenter2\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Consul namespace that the token will be created in. Applicable for Vault 1.10+ and Consul 1.7+.",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The admin partition that the token will be created in. Applicable for Vault 1.10+ and Consul 1.11+.",
+			},
 			"max_ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -106,10 +142,32 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	path := consulSecretBackendRolePath(backend, name)
 
 	policies := d.Get("policies").([]interface{})
+	consulPolicies := d.Get("consul_policies").(*schema.Set).List()
+	nodeIdentities := d.Get("node_identities").(*schema.Set).List()
+	serviceIdentities := d.Get("service_identities").(*schema.Set).List()
+
+	if len(policies) == 0 && len(consulPolicies) == 0 && len(nodeIdentities) == 0 && len(serviceIdentities) == 0 {
+		return fmt.Errorf("at least one of \"policies\", \"consul_policies\", \"node_identities\" or \"service_identities\" must be set for Consul secret backend role %s", name)
+	}
 
 	payload := map[string]interface{}{
 		"policies": policies,
 	}
+	if len(consulPolicies) > 0 {
+		payload["consul_policies"] = consulPolicies
+	}
+	if len(nodeIdentities) > 0 {
+		payload["node_identities"] = nodeIdentities
+	}
+	if len(serviceIdentities) > 0 {
+		payload["service_identities"] = serviceIdentities
+	}
+	if v, ok := d.GetOk("consul_namespace"); ok {
+		payload["consul_namespace"] = v.(string)
+	}
+	if v, ok := d.GetOk("partition"); ok {
+		payload["partition"] = v.(string)
+	}
 
 	if v, ok := d.GetOkExists("max_ttl"); ok {
 		payload["max_ttl"] = v
@@ -173,6 +231,11 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("backend", backend)
 	}
 	d.Set("policies", data["policies"])
+	for _, k := range []string{"consul_policies", "node_identities", "service_identities", "consul_namespace", "partition"} {
+		if v, ok := data[k]; ok {
+			d.Set(k, v)
+		}
+	}
 	d.Set("max_ttl", data["max_ttl"])
 	d.Set("ttl", data["ttl"])
 	d.Set("token_type", data["token_type"])
@@ -16,7 +16,7 @@ import (
 var (
 	databaseSecretBackendConnectionBackendFromPathRegex = regexp.MustCompile("^(.+)/config/.+$")
 	databaseSecretBackendConnectionNameFromPathRegex    = regexp.MustCompile("^.+/config/(.+$)")
-	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch"}
+	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch", "custom"}
 )
 
 func databaseSecretBackendConnectionResource() *schema.Resource {
@@ -245,6 +245,31 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				ConflictsWith: util.CalculateConflictsWith("oracle", dbBackendTypes),
 			},
 
+			"custom": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Connection parameters for a custom database plugin.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plugin_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the custom plugin as registered in Vault's plugin catalog.",
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "A map of connection parameters to pass to the plugin.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+				MaxItems:      1,
+				ConflictsWith: util.CalculateConflictsWith("custom", dbBackendTypes),
+			},
+
 			"backend": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -311,6 +336,8 @@ func getDatabasePluginName(d *schema.ResourceData) (string, error) {
 		return "postgresql-database-plugin", nil
 	case len(d.Get("elasticsearch").([]interface{})) > 0:
 		return "elasticsearch-database-plugin", nil
+	case len(d.Get("custom").([]interface{})) > 0:
+		return d.Get("custom.0.plugin_name").(string), nil
 	default:
 		return "", fmt.Errorf("at least one database plugin must be configured")
 	}
@@ -325,6 +352,15 @@ func getDatabaseAPIData(d *schema.ResourceData) (map[string]interface{}, error)
 		"plugin_name": plugin,
 	}
 
+	if len(d.Get("custom").([]interface{})) > 0 {
+		if m, ok := d.GetOk("custom.0.options"); ok {
+			for k, v := range m.(map[string]interface{}) {
+				data[k] = v.(string)
+			}
+		}
+		return data, nil
+	}
+
 	switch plugin {
 	case "cassandra-database-plugin":
 		if v, ok := d.GetOk("cassandra.0.hosts"); ok {
@@ -640,6 +676,27 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 		d.Set("postgresql", getConnectionDetailsFromResponse(d, "postgresql.0.", resp))
 	case "elasticsearch-database-plugin":
 		d.Set("elasticsearch", getElasticsearchConnectionDetailsFromResponse(d, "elasticsearch.0.", resp))
+	default:
+		result := map[string]interface{}{
+			"plugin_name": resp.Data["plugin_name"].(string),
+		}
+		options := map[string]interface{}{}
+		if details, ok := resp.Data["connection_details"].(map[string]interface{}); ok {
+			for k, v := range details {
+				options[k] = fmt.Sprintf("%v", v)
+			}
+		}
+		// connection details like passwords are not returned by the API, so
+		// keep whatever we have in state/config for options that are missing
+		if m, ok := d.GetOk("custom.0.options"); ok {
+			for k, v := range m.(map[string]interface{}) {
+				if _, ok := options[k]; !ok {
+					options[k] = v.(string)
+				}
+			}
+		}
+		result["options"] = options
+		d.Set("custom", []map[string]interface{}{result})
 	}
 
 	if err != nil {
@@ -80,6 +80,19 @@ func databaseSecretBackendRoleResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Database statements to execute to renew a user.",
 			},
+			"credential_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the type of credential that will be generated for the role.",
+			},
+			"credential_config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the configuration for the given credential_type.",
+			},
 		},
 	}
 }
@@ -112,6 +125,12 @@ func databaseSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 	if v, ok := d.GetOkExists("renew_statements"); ok && v != "" {
 		data["renew_statements"] = v
 	}
+	if v, ok := d.GetOk("credential_type"); ok {
+		data["credential_type"] = v
+	}
+	if v, ok := d.GetOk("credential_config"); ok {
+		data["credential_config"] = v
+	}
 
 	log.Printf("[DEBUG] Creating role %q on database backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
@@ -193,6 +212,12 @@ func databaseSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 	d.Set("renew_statements", renew)
+	if v, ok := secret.Data["credential_type"]; ok {
+		d.Set("credential_type", v)
+	}
+	if v, ok := secret.Data["credential_config"]; ok {
+		d.Set("credential_config", v)
+	}
 
 	if v, ok := secret.Data["default_ttl"]; ok {
 		n, err := v.(json.Number).Int64()
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func databaseSecretBackendRotateRootResource() *schema.Resource {
+	return &schema.Resource{
+		Create: databaseSecretBackendRotateRootCreate,
+		Read:   databaseSecretBackendRotateRootRead,
+		Delete: databaseSecretBackendRotateRootDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Database Secret Backend the connection belongs to.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database connection whose root credentials should be rotated.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value that, when changed, triggers another rotation of the root credentials.",
+			},
+		},
+	}
+}
+
+func databaseSecretBackendRotateRootCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	connection := d.Get("connection_name").(string)
+
+	path := databaseSecretBackendRotateRootPath(backend, connection)
+
+	log.Printf("[DEBUG] Rotating root credentials for connection %q on database backend %q", connection, backend)
+	_, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return fmt.Errorf("error rotating root credentials for connection %q on backend %q: %s", connection, backend, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials for connection %q on database backend %q", connection, backend)
+
+	d.SetId(path)
+	return nil
+}
+
+func databaseSecretBackendRotateRootRead(d *schema.ResourceData, meta interface{}) error {
+	// The rotate-root endpoint is write-only; there is nothing to read back.
+	return nil
+}
+
+func databaseSecretBackendRotateRootDelete(d *schema.ResourceData, meta interface{}) error {
+	// Rotation cannot be undone; removing the resource only removes it from state.
+	return nil
+}
+
+func databaseSecretBackendRotateRootPath(backend, connection string) string {
+	return strings.Trim(backend, "/") + "/rotate-root/" + strings.Trim(connection, "/")
+}
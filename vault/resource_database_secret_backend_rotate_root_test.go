@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDatabaseSecretBackendRotateRoot_basic(t *testing.T) {
+	connURL := os.Getenv("MYSQL_ROTATE_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_ROTATE_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	dbName := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendRotateRootConfig_basic(dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_rotate_root.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_rotate_root.test", "connection_name", dbName),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_rotate_root.test", "id", backend+"/rotate-root/"+dbName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendRotateRootConfig_basic(db, path, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["dev", "prod"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_rotate_root" "test" {
+  backend = "${vault_mount.db.path}"
+  connection_name = "${vault_database_secret_backend_connection.test.name}"
+}
+`, path, db, connURL)
+}
@@ -47,9 +47,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_schedule"},
+				Description:   "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -58,6 +59,23 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 					return
 				},
 			},
+			"rotation_schedule": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description:   "A cron-style string that will define the schedule on which rotations should occur.",
+			},
+			"rotation_window": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The amount of time in seconds in which the rotations are allowed to occur starting from a given rotation_schedule.",
+			},
+			"self_managed_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password corresponding to the username in the database. Required when using the Rootless Password Rotation workflow for static roles.",
+			},
 			"db_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -84,11 +102,27 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
 
+	if v, ok := d.GetOk("rotation_period"); ok {
+		data["rotation_period"] = v
+	}
+	if v, ok := d.GetOk("rotation_schedule"); ok {
+		data["rotation_schedule"] = v
+	}
+	if v, ok := d.GetOk("rotation_window"); ok {
+		data["rotation_window"] = v
+	}
+	if v, ok := d.GetOk("self_managed_password"); ok {
+		data["self_managed_password"] = v
+	}
+
+	if data["rotation_period"] == nil && data["rotation_schedule"] == nil {
+		return fmt.Errorf("one of rotation_period or rotation_schedule must be set for static role %q", name)
+	}
+
 	if v, ok := d.GetOkExists("rotation_statements"); ok && v != "" {
 		data["rotation_statements"] = v
 	}
@@ -148,6 +182,18 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 		d.Set("rotation_period", n)
 	}
 
+	if v, ok := role.Data["rotation_schedule"]; ok {
+		d.Set("rotation_schedule", v)
+	}
+
+	if v, ok := role.Data["rotation_window"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for rotation_window of %q", v, path)
+		}
+		d.Set("rotation_window", n)
+	}
+
 	var rotation []string
 	if rotationStr, ok := role.Data["rotation_statements"].(string); ok {
 		rotation = append(rotation, rotationStr)
@@ -20,6 +20,9 @@ func gcpAuthBackendResource() *schema.Resource {
 		Read:   gcpAuthBackendRead,
 		Delete: gcpAuthBackendDelete,
 		Exists: gcpAuthBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"credentials": {
@@ -159,6 +162,7 @@ func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("path", d.Id())
 	d.Set("private_key_id", resp.Data["private_key_id"])
 	d.Set("client_id", resp.Data["client_id"])
 	d.Set("project_id", resp.Data["project_id"])
@@ -241,7 +241,7 @@ func gcpAuthResourceCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error writing GCP auth role %q: %s", path, err)
+		return fmt.Errorf("error writing GCP auth role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Wrote role %q to GCP auth backend", path)
 
@@ -258,7 +258,7 @@ func gcpAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating role %q in GCP auth backend", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
-		return fmt.Errorf("Error updating GCP auth role %q: %s", path, err)
+		return fmt.Errorf("error updating GCP auth role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Updated role %q to GCP auth backend", path)
 
@@ -272,7 +272,7 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Reading GCP role %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
-		return fmt.Errorf("Error reading GCP role %q: %s", path, err)
+		return fmt.Errorf("error reading GCP role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Read GCP role %q", path)
 
@@ -376,7 +376,7 @@ func gcpAuthResourceDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting GCP role %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("Error deleting GCP role %q", path)
+		return fmt.Errorf("error deleting GCP role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Deleted GCP role %q", path)
 
@@ -400,7 +400,7 @@ func gcpAuthResourceExists(d *schema.ResourceData, meta interface{}) (bool, erro
 func gcpAuthResourceBackendFromPath(path string) (string, error) {
 	var parts = strings.Split(path, "/")
 	if len(parts) != 4 {
-		return "", fmt.Errorf("Expecdted 4 parts in path '%s'", path)
+		return "", fmt.Errorf("expected 4 parts in path '%s'", path)
 	}
 	return parts[1], nil
 }
@@ -408,7 +408,7 @@ func gcpAuthResourceBackendFromPath(path string) (string, error) {
 func gcpAuthResourceRoleFromPath(path string) (string, error) {
 	var parts = strings.Split(path, "/")
 	if len(parts) != 4 {
-		return "", fmt.Errorf("Expecdted 4 parts in path '%s'", path)
+		return "", fmt.Errorf("expected 4 parts in path '%s'", path)
 	}
 	return parts[3], nil
 }
@@ -50,6 +50,24 @@ func gcpSecretBackendResource() *schema.Resource {
 				StateFunc:    NormalizeDataJSON,
 				ValidateFunc: ValidateDataJSON,
 			},
+			"identity_token_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The audience claim value for plugin identity tokens. Mutually exclusive with credentials.",
+				ConflictsWith: []string{"credentials"},
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds.",
+			},
+			"service_account_email": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Service Account to impersonate for plugin workload identity federation. Mutually exclusive with credentials.",
+				ConflictsWith: []string{"credentials"},
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -105,15 +123,25 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("max_lease_ttl_seconds")
 
 	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
+	data := map[string]interface{}{}
 	if credentials != "" {
-		data := map[string]interface{}{
-			"credentials": credentials,
-		}
+		data["credentials"] = credentials
+	} else {
+		log.Printf("[DEBUG] No credentials configured")
+	}
+	if v, ok := d.GetOk("identity_token_audience"); ok {
+		data["identity_token_audience"] = v.(string)
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("service_account_email"); ok {
+		data["service_account_email"] = v.(string)
+	}
+	if len(data) > 0 {
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
-	} else {
-		log.Printf("[DEBUG] No credentials configured")
 	}
 	log.Printf("[DEBUG] Wrote GCP configuration to %q", configPath)
 	d.Partial(false)
@@ -170,16 +198,28 @@ func gcpSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("max_lease_ttl_seconds")
 	}
 
-	if d.HasChange("credentials") {
+	if d.HasChange("credentials") || d.HasChange("identity_token_audience") || d.HasChange("identity_token_ttl") || d.HasChange("service_account_email") {
 		data := map[string]interface{}{
 			"credentials": d.Get("credentials"),
 		}
+		if v, ok := d.GetOk("identity_token_audience"); ok {
+			data["identity_token_audience"] = v.(string)
+		}
+		if v, ok := d.GetOk("identity_token_ttl"); ok {
+			data["identity_token_ttl"] = v.(int)
+		}
+		if v, ok := d.GetOk("service_account_email"); ok {
+			data["service_account_email"] = v.(string)
+		}
 		configPath := gcpSecretBackendConfigPath(path)
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("error writing GCP credentials for %q: %s", path, err)
 		}
 		log.Printf("[DEBUG] Updated credentials for %q", path)
 		d.SetPartial("credentials")
+		d.SetPartial("identity_token_audience")
+		d.SetPartial("identity_token_ttl")
+		d.SetPartial("service_account_email")
 	}
 
 	d.Partial(false)
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPSecretImpersonatedAccount_basic(t *testing.T) {
+	credentials, _ := getTestGCPCreds(t)
+	serviceAccount := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if serviceAccount == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("imp")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGCPSecretImpersonatedAccountCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPSecretImpersonatedAccountConfig_basic(backend, credentials, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "impersonated_account", name),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "service_account_email", serviceAccount),
+				),
+			},
+		},
+	})
+}
+
+func testAccGCPSecretImpersonatedAccountCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_impersonated_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("impersonated account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPSecretImpersonatedAccountConfig_basic(backend, credentials, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_impersonated_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  impersonated_account = "%s"
+  service_account_email = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+}
+`, backend, credentials, name, serviceAccount)
+}
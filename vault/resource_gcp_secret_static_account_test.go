@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPSecretStaticAccount_basic(t *testing.T) {
+	credentials, _ := getTestGCPCreds(t)
+	serviceAccount := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if serviceAccount == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("static")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGCPSecretStaticAccountCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPSecretStaticAccountConfig_basic(backend, credentials, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "static_account", name),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "secret_type", "access_token"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_email", serviceAccount),
+				),
+			},
+		},
+	})
+}
+
+func testAccGCPSecretStaticAccountCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_static_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("static account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPSecretStaticAccountConfig_basic(backend, credentials, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "%s"
+  secret_type = "access_token"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+  service_account_email = "%s"
+}
+`, backend, credentials, name, serviceAccount)
+}
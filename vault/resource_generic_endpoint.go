@@ -158,7 +158,7 @@ func genericEndpointResourceDelete(d *schema.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Deleting vault_generic_endpoint from %q", path)
 		_, err := client.Logical().Delete(path)
 		if err != nil {
-			return fmt.Errorf("error deleting %q from Vault: %q", path, err)
+			return fmt.Errorf("error deleting %q from Vault: %s", path, err)
 		}
 	}
 
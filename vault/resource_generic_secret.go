@@ -61,6 +61,15 @@ func genericSecretResource() *schema.Resource {
 				Description: "Don't attempt to read the token from Vault if true; drift won't be detected.",
 			},
 
+			"managed_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Keys of the secret owned by Terraform. Other keys are preserved on write and ignored when detecting drift, so values appended at runtime don't cause diffs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"data": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -118,6 +127,22 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error determining if it's a v2 path: %s", err)
 	}
 
+	// Carry over any keys not owned by Terraform so the write doesn't
+	// destroy values appended to the secret at runtime.
+	if managedKeys := d.Get("managed_keys").(*schema.Set); managedKeys.Len() > 0 {
+		existing, err := versionedSecret(latestSecretVersion, originalPath, client)
+		if err != nil {
+			return fmt.Errorf("error reading existing secret %q: %s", originalPath, err)
+		}
+		if existing != nil {
+			for k, v := range existing.Data {
+				if _, ok := data[k]; !ok && !managedKeys.Contains(k) {
+					data[k] = v
+				}
+			}
+		}
+	}
+
 	if v2 {
 		path = addPrefixToVKVPath(path, mountPath, "data")
 		data = map[string]interface{}{
@@ -190,7 +215,19 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] secret: %#v", secret)
 
 		data = secret.Data
-		jsonData, err := json.Marshal(secret.Data)
+
+		// Only compare the keys owned by Terraform, so keys appended to the
+		// secret at runtime don't show up as perpetual drift.
+		if managedKeys := d.Get("managed_keys").(*schema.Set); managedKeys.Len() > 0 {
+			data = map[string]interface{}{}
+			for k, v := range secret.Data {
+				if managedKeys.Contains(k) {
+					data[k] = v
+				}
+			}
+		}
+
+		jsonData, err := json.Marshal(data)
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
 		}
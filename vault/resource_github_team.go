@@ -164,6 +164,12 @@ func githubTeamRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if dt == nil {
+		log.Printf("[WARN] github team mapping '%s' not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
 	if v, ok := dt.Data["key"]; ok {
 		d.Set("team", v.(string))
 	} else {
@@ -160,6 +160,12 @@ func githubUserRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if dt == nil {
+		log.Printf("[WARN] github user mapping '%s' not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
 	if v, ok := dt.Data["key"]; ok {
 		d.Set("user", v.(string))
 	} else {
@@ -172,10 +172,10 @@ func identityEntityDelete(d *schema.ResourceData, meta interface{}) error {
 	vaultMutexKV.Lock(path)
 	defer vaultMutexKV.Unlock(path)
 
-	log.Printf("[DEBUG] Deleting IdentityEntitty %q", id)
+	log.Printf("[DEBUG] Deleting IdentityEntity %q", id)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("error IdentityEntity %q", id)
+		return fmt.Errorf("error deleting IdentityEntity %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Deleted IdentityEntity %q", id)
 
@@ -89,9 +89,16 @@ func identityGroupResource() *schema.Resource {
 					if d.Get("type").(string) == "external" {
 						return true
 					}
-					return false
+					return d.Get("external_member_entity_ids").(bool)
 				},
 			},
+
+			"external_member_entity_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage member entities externally through `vault_identity_group_member_entity_ids`",
+			},
 		},
 	}
 }
@@ -107,8 +114,10 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 		}
 	}
 
-	if memberEntityIDs, ok := d.GetOk("member_entity_ids"); ok && d.Get("type").(string) == "internal" {
-		data["member_entity_ids"] = memberEntityIDs.(*schema.Set).List()
+	if externalMemberEntityIDs, ok := d.GetOk("external_member_entity_ids"); !(ok && externalMemberEntityIDs.(bool)) {
+		if memberEntityIDs, ok := d.GetOk("member_entity_ids"); ok && d.Get("type").(string) == "internal" {
+			data["member_entity_ids"] = memberEntityIDs.(*schema.Set).List()
+		}
 	}
 
 	if memberGroupIDs, ok := d.GetOk("member_group_ids"); ok {
@@ -249,6 +258,21 @@ func identityGroupIDPath(id string) string {
 	return fmt.Sprintf("%s/id/%s", identityGroupPath, id)
 }
 
+func readIdentityGroupMemberEntityIds(client *api.Client, groupID string) ([]interface{}, error) {
+	resp, err := readIdentityGroup(client, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("error IdentityGroup %s does not exist", groupID)
+	}
+
+	if v, ok := resp.Data["member_entity_ids"]; ok && v != nil {
+		return v.([]interface{}), nil
+	}
+	return make([]interface{}, 0), nil
+}
+
 func readIdentityGroupPolicies(client *api.Client, groupID string) ([]interface{}, error) {
 	resp, err := readIdentityGroup(client, groupID)
 	if err != nil {
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityGroupMemberEntityIds_basic(t *testing.T) {
+	group := acctest.RandomWithPrefix("tf-test-group")
+	entity := acctest.RandomWithPrefix("tf-test-entity")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberEntityIdsConfig_basic(group, entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_entity_ids.test", "member_entity_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group_member_entity_ids.test", "exclusive", "true"),
+					resource.TestCheckResourceAttrSet("vault_identity_group_member_entity_ids.test", "group_id"),
+					resource.TestCheckResourceAttr("vault_identity_group_member_entity_ids.test", "group_name", group),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberEntityIdsConfig_updated(group, entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_entity_ids.test", "member_entity_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityGroupMemberEntityIdsConfig_basic(group, entity string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "internal"
+  external_member_entity_ids = true
+}
+
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_identity_group_member_entity_ids" "test" {
+  group_id = "${vault_identity_group.group.id}"
+  member_entity_ids = ["${vault_identity_entity.entity.id}"]
+}
+`, group, entity)
+}
+
+func testAccIdentityGroupMemberEntityIdsConfig_updated(group, entity string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "internal"
+  external_member_entity_ids = true
+}
+
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_identity_entity" "entity2" {
+  name = "%s-2"
+}
+
+resource "vault_identity_group_member_entity_ids" "test" {
+  group_id = "${vault_identity_group.group.id}"
+  member_entity_ids = ["${vault_identity_entity.entity.id}", "${vault_identity_entity.entity2.id}"]
+}
+`, group, entity, entity)
+}
@@ -0,0 +1,296 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMfaMethodPathTemplate = "identity/mfa/method/%s"
+
+// identityMfaCommonSchema is shared by all of the identity/mfa method types.
+func identityMfaCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"username_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A template string for mapping Identity names to MFA methods.",
+		},
+		"method_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Method ID.",
+		},
+		"mount_accessor": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Mount accessor.",
+		},
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Method's namespace ID.",
+		},
+	}
+}
+
+// identityMfaMethodResource builds a resource for an identity/mfa method
+// type. The type-specific fields in methodSchema are merged with the common
+// schema, and the keys of methodSchema are written to and read from Vault.
+func identityMfaMethodResource(methodType string, methodSchema map[string]*schema.Schema) *schema.Resource {
+	fields := []string{"username_format"}
+	s := identityMfaCommonSchema()
+	for k, v := range methodSchema {
+		s[k] = v
+		fields = append(fields, k)
+	}
+
+	return &schema.Resource{
+		Create: identityMfaMethodCreate(methodType, fields),
+		Update: identityMfaMethodUpdate(methodType, fields),
+		Read:   identityMfaMethodRead(methodType, fields),
+		Delete: identityMfaMethodDelete(methodType),
+		Exists: identityMfaMethodExists(methodType),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func identityMfaMethodData(d *schema.ResourceData, fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, k := range fields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+func identityMfaMethodCreate(methodType string, fields []string) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*api.Client)
+		path := fmt.Sprintf(identityMfaMethodPathTemplate, methodType)
+
+		log.Printf("[DEBUG] Creating %s MFA method", methodType)
+		resp, err := client.Logical().Write(path, identityMfaMethodData(d, fields))
+		if err != nil {
+			return fmt.Errorf("error creating %s MFA method: %s", methodType, err)
+		}
+		log.Printf("[DEBUG] Created %s MFA method", methodType)
+		if resp == nil {
+			return fmt.Errorf("no method ID returned when creating %s MFA method", methodType)
+		}
+
+		d.SetId(resp.Data["method_id"].(string))
+
+		return identityMfaMethodRead(methodType, fields)(d, meta)
+	}
+}
+
+func identityMfaMethodUpdate(methodType string, fields []string) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*api.Client)
+		id := d.Id()
+		path := fmt.Sprintf(identityMfaMethodPathTemplate, methodType) + "/" + id
+
+		log.Printf("[DEBUG] Updating %s MFA method %q", methodType, id)
+		_, err := client.Logical().Write(path, identityMfaMethodData(d, fields))
+		if err != nil {
+			return fmt.Errorf("error updating %s MFA method %q: %s", methodType, id, err)
+		}
+		log.Printf("[DEBUG] Updated %s MFA method %q", methodType, id)
+
+		return identityMfaMethodRead(methodType, fields)(d, meta)
+	}
+}
+
+func identityMfaMethodRead(methodType string, fields []string) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*api.Client)
+		id := d.Id()
+		path := fmt.Sprintf(identityMfaMethodPathTemplate, methodType) + "/" + id
+
+		log.Printf("[DEBUG] Reading %s MFA method %q", methodType, id)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading %s MFA method %q: %s", methodType, id, err)
+		}
+		log.Printf("[DEBUG] Read %s MFA method %q", methodType, id)
+		if resp == nil {
+			log.Printf("[WARN] %s MFA method %q not found, removing from state", methodType, id)
+			d.SetId("")
+			return nil
+		}
+
+		for _, k := range append([]string{"method_id", "mount_accessor", "namespace_id"}, fields...) {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		return nil
+	}
+}
+
+func identityMfaMethodDelete(methodType string) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*api.Client)
+		id := d.Id()
+		path := fmt.Sprintf(identityMfaMethodPathTemplate, methodType) + "/" + id
+
+		log.Printf("[DEBUG] Deleting %s MFA method %q", methodType, id)
+		_, err := client.Logical().Delete(path)
+		if err != nil {
+			return fmt.Errorf("error deleting %s MFA method %q: %s", methodType, id, err)
+		}
+		log.Printf("[DEBUG] Deleted %s MFA method %q", methodType, id)
+		return nil
+	}
+}
+
+func identityMfaMethodExists(methodType string) schema.ExistsFunc {
+	return func(d *schema.ResourceData, meta interface{}) (bool, error) {
+		client := meta.(*api.Client)
+		id := d.Id()
+		path := fmt.Sprintf(identityMfaMethodPathTemplate, methodType) + "/" + id
+
+		log.Printf("[DEBUG] Checking if %s MFA method %q exists", methodType, id)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return true, fmt.Errorf("error checking if %s MFA method %q exists: %s", methodType, id, err)
+		}
+		log.Printf("[DEBUG] Checked if %s MFA method %q exists", methodType, id)
+		return resp != nil, nil
+	}
+}
+
+func identityMfaDuoResource() *schema.Resource {
+	return identityMfaMethodResource("duo", map[string]*schema.Schema{
+		"secret_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Secret key for Duo.",
+		},
+		"integration_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Integration key for Duo.",
+		},
+		"api_hostname": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "API hostname for Duo.",
+		},
+		"push_info": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Push information for Duo.",
+		},
+		"use_passcode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Require passcode upon MFA validation.",
+		},
+	})
+}
+
+func identityMfaOktaResource() *schema.Resource {
+	return identityMfaMethodResource("okta", map[string]*schema.Schema{
+		"org_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the organization to be used in the Okta API.",
+		},
+		"api_token": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Okta API token.",
+		},
+		"base_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The base domain to use for API requests, for example okta.com, oktapreview.com or okta-emea.com.",
+		},
+		"primary_email": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Only match the primary email for the account.",
+		},
+	})
+}
+
+func identityMfaPingidResource() *schema.Resource {
+	return identityMfaMethodResource("pingid", map[string]*schema.Schema{
+		"settings_file_base64": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "A base64-encoded third-party settings file retrieved from PingID's configuration page.",
+		},
+	})
+}
+
+func identityMfaTotpResource() *schema.Resource {
+	return identityMfaMethodResource("totp", map[string]*schema.Schema{
+		"issuer": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the key's issuing organization.",
+		},
+		"period": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     30,
+			Description: "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+		},
+		"key_size": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     20,
+			Description: "Specifies the size in bytes of the generated key.",
+		},
+		"qr_size": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     200,
+			Description: "The pixel size of the generated square QR code.",
+		},
+		"algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "SHA1",
+			Description:  "Specifies the hashing algorithm used to generate the TOTP code.",
+			ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+		},
+		"digits": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      6,
+			Description:  "The number of digits in the generated TOTP token, 6 or 8.",
+			ValidateFunc: validation.IntInSlice([]int{6, 8}),
+		},
+		"skew": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "The number of delay periods that are allowed when validating a TOTP token, 0 or 1.",
+			ValidateFunc: validation.IntInSlice([]int{0, 1}),
+		},
+		"max_validation_attempts": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The maximum number of consecutive failed validation attempts allowed.",
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMfaLoginEnforcementPathTemplate = "identity/mfa/login-enforcement/%s"
+
+func identityMfaLoginEnforcementResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityMfaLoginEnforcementWrite,
+		Update: identityMfaLoginEnforcementWrite,
+		Read:   identityMfaLoginEnforcementRead,
+		Delete: identityMfaLoginEnforcementDelete,
+		Exists: identityMfaLoginEnforcementExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the login enforcement.",
+			},
+			"mfa_method_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of MFA method IDs, of which at least one must validate during login.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"auth_method_accessors": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of auth method accessor IDs to enforce the methods on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"auth_method_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of auth method types to enforce the methods on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"identity_entity_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of identity entity IDs to enforce the methods on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"identity_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of identity group IDs to enforce the methods on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Enforcement's namespace ID.",
+			},
+		},
+	}
+}
+
+func identityMfaLoginEnforcementWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := fmt.Sprintf(identityMfaLoginEnforcementPathTemplate, name)
+
+	data := map[string]interface{}{
+		"mfa_method_ids": d.Get("mfa_method_ids").(*schema.Set).List(),
+	}
+	for _, k := range []string{"auth_method_accessors", "auth_method_types", "identity_entity_ids", "identity_group_ids"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(*schema.Set).List()
+		}
+	}
+
+	log.Printf("[DEBUG] Writing MFA login enforcement %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA login enforcement %q", name)
+
+	d.SetId(name)
+
+	return identityMfaLoginEnforcementRead(d, meta)
+}
+
+func identityMfaLoginEnforcementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := fmt.Sprintf(identityMfaLoginEnforcementPathTemplate, name)
+
+	log.Printf("[DEBUG] Reading MFA login enforcement %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read MFA login enforcement %q", name)
+	if resp == nil {
+		log.Printf("[WARN] MFA login enforcement %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"mfa_method_ids", "auth_method_accessors", "auth_method_types", "identity_entity_ids", "identity_group_ids", "namespace_id"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
+
+func identityMfaLoginEnforcementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := fmt.Sprintf(identityMfaLoginEnforcementPathTemplate, name)
+
+	log.Printf("[DEBUG] Deleting MFA login enforcement %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted MFA login enforcement %q", name)
+	return nil
+}
+
+func identityMfaLoginEnforcementExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := fmt.Sprintf(identityMfaLoginEnforcementPathTemplate, name)
+
+	log.Printf("[DEBUG] Checking if MFA login enforcement %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if MFA login enforcement %q exists: %s", name, err)
+	}
+	log.Printf("[DEBUG] Checked if MFA login enforcement %q exists", name)
+	return resp != nil, nil
+}
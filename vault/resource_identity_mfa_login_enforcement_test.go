@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityMfaLoginEnforcement_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-enforcement")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityMfaLoginEnforcementCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMfaLoginEnforcementConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_mfa_login_enforcement.test", "name", name),
+					resource.TestCheckResourceAttr("vault_identity_mfa_login_enforcement.test", "mfa_method_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_mfa_login_enforcement.test", "auth_method_types.0", "userpass"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityMfaLoginEnforcementCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_mfa_login_enforcement" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("login enforcement %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityMfaLoginEnforcementConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "test-issuer"
+}
+
+resource "vault_identity_mfa_login_enforcement" "test" {
+  name = "%s"
+  mfa_method_ids = ["${vault_identity_mfa_totp.test.id}"]
+  auth_method_types = ["userpass"]
+}
+`, name)
+}
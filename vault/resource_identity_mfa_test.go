@@ -0,0 +1,134 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityMfaTotp_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityMfaCheckDestroy("vault_identity_mfa_totp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMfaTotpConfig_basic("test-issuer", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_mfa_totp.test", "issuer", "test-issuer"),
+					resource.TestCheckResourceAttr("vault_identity_mfa_totp.test", "period", "30"),
+				),
+			},
+			{
+				Config: testAccIdentityMfaTotpConfig_basic("test-issuer", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_mfa_totp.test", "period", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityMfaDuo_basic(t *testing.T) {
+	secretKey := os.Getenv("DUO_SECRET_KEY")
+	integrationKey := os.Getenv("DUO_INTEGRATION_KEY")
+	apiHostname := os.Getenv("DUO_API_HOSTNAME")
+	if secretKey == "" {
+		t.Skip("DUO_SECRET_KEY not set")
+	}
+	if integrationKey == "" {
+		t.Skip("DUO_INTEGRATION_KEY not set")
+	}
+	if apiHostname == "" {
+		t.Skip("DUO_API_HOSTNAME not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityMfaCheckDestroy("vault_identity_mfa_duo"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMfaDuoConfig_basic(secretKey, integrationKey, apiHostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_mfa_duo.test", "api_hostname", apiHostname),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityMfaOkta_basic(t *testing.T) {
+	orgName := os.Getenv("OKTA_ORG_NAME")
+	apiToken := os.Getenv("OKTA_API_TOKEN")
+	if orgName == "" {
+		t.Skip("OKTA_ORG_NAME not set")
+	}
+	if apiToken == "" {
+		t.Skip("OKTA_API_TOKEN not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityMfaCheckDestroy("vault_identity_mfa_okta"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMfaOktaConfig_basic(orgName, apiToken),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_mfa_okta.test", "org_name", orgName),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityMfaCheckDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			secret, err := client.Logical().Read(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if secret != nil {
+				return fmt.Errorf("MFA method %q still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccIdentityMfaTotpConfig_basic(issuer string, period int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "%s"
+  period = %d
+}
+`, issuer, period)
+}
+
+func testAccIdentityMfaDuoConfig_basic(secretKey, integrationKey, apiHostname string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_duo" "test" {
+  secret_key = "%s"
+  integration_key = "%s"
+  api_hostname = "%s"
+}
+`, secretKey, integrationKey, apiHostname)
+}
+
+func testAccIdentityMfaOktaConfig_basic(orgName, apiToken string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_okta" "test" {
+  org_name = "%s"
+  api_token = "%s"
+}
+`, orgName, apiToken)
+}
@@ -131,6 +131,10 @@ func identityOidcExists(d *schema.ResourceData, meta interface{}) (bool, error)
 		return true, fmt.Errorf("error checking if IdentityOidc for %q is set: %s", addr, err)
 	}
 	log.Printf("[DEBUG] Checked if IdentityOidc for %q is set", addr)
+	if resp == nil {
+		return false, nil
+	}
 
-	return resp.Data["issuer"].(string) != "", nil
+	issuer, ok := resp.Data["issuer"].(string)
+	return ok && issuer != "", nil
 }
@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcAssignmentPathTemplate = "identity/oidc/assignment/%s"
+
+func identityOidcAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcAssignmentCreate,
+		Update: identityOidcAssignmentUpdate,
+		Read:   identityOidcAssignmentRead,
+		Delete: identityOidcAssignmentDelete,
+		Exists: identityOidcAssignmentExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the assignment.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"entity_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault entity IDs.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault group IDs.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityOidcAssignmentUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["entity_ids"] = d.Get("entity_ids").(*schema.Set).List()
+	data["group_ids"] = d.Get("group_ids").(*schema.Set).List()
+}
+
+func identityOidcAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	path := identityOidcAssignmentPath(name)
+
+	data := make(map[string]interface{})
+
+	identityOidcAssignmentUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcAssignment %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcAssignment %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcAssignmentRead(d, meta)
+}
+
+func identityOidcAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+	log.Printf("[DEBUG] Updating IdentityOidcAssignment %s at %s", name, path)
+
+	data := map[string]interface{}{}
+
+	identityOidcAssignmentUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error updating IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityOidcAssignment %q", name)
+
+	return identityOidcAssignmentRead(d, meta)
+}
+
+func identityOidcAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcAssignment %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcAssignment %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcAssignment %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"entity_ids", "group_ids"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcAssignment %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcAssignment %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcAssignment %q", name)
+
+	return nil
+}
+
+func identityOidcAssignmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Checking if IdentityOidcAssignment %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if IdentityOidcAssignment %s exists: %q", name, err)
+	}
+	log.Printf("[DEBUG] Checked if IdentityOidcAssignment %q exists", name)
+
+	return resp != nil, nil
+}
+
+func identityOidcAssignmentPath(name string) string {
+	return fmt.Sprintf(identityOidcAssignmentPathTemplate, name)
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcAssignment_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-assignment")
+	entity := acctest.RandomWithPrefix("tf-test-entity")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityOidcAssignmentCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcAssignmentConfig_basic(name, entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_assignment.test", "name", name),
+					resource.TestCheckResourceAttr("vault_identity_oidc_assignment.test", "entity_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcAssignmentConfig_updated(name, entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_assignment.test", "group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "vault_identity_oidc_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcAssignmentCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_assignment" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("assignment %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcAssignmentConfig_basic(name, entity string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_identity_oidc_assignment" "test" {
+  name = "%s"
+  entity_ids = ["${vault_identity_entity.entity.id}"]
+}
+`, entity, name)
+}
+
+func testAccIdentityOidcAssignmentConfig_updated(name, entity string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_identity_group" "group" {
+  name = "%s-group"
+  type = "internal"
+}
+
+resource "vault_identity_oidc_assignment" "test" {
+  name = "%s"
+  entity_ids = ["${vault_identity_entity.entity.id}"]
+  group_ids = ["${vault_identity_group.group.id}"]
+}
+`, entity, name, name)
+}
@@ -0,0 +1,214 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcClientPathTemplate = "identity/oidc/client/%s"
+
+func identityOidcClient() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcClientCreate,
+		Update: identityOidcClientUpdate,
+		Read:   identityOidcClientRead,
+		Delete: identityOidcClientDelete,
+		Exists: identityOidcClientExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the client.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"key": {
+				Type:        schema.TypeString,
+				Description: "A reference to a named key resource. This cannot be modified after creation.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+			},
+
+			"redirect_uris": {
+				Type:        schema.TypeSet,
+				Description: "Redirection URI values used by the client. One of these values must exactly match the redirect_uri parameter value used in each authentication request.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"assignments": {
+				Type:        schema.TypeSet,
+				Description: "A list of assignment resources associated with the client.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"id_token_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time-to-live for ID tokens obtained by the client in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"access_token_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time-to-live for access tokens obtained by the client in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"client_type": {
+				Type:         schema.TypeString,
+				Description:  "The client type based on its ability to maintain confidentiality of credentials, confidential or public.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"confidential", "public"}, false),
+			},
+
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The Client ID returned by Vault.",
+				Computed:    true,
+			},
+
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The Client Secret returned by Vault.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func identityOidcClientUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["key"] = d.Get("key").(string)
+	data["redirect_uris"] = d.Get("redirect_uris").(*schema.Set).List()
+	data["assignments"] = d.Get("assignments").(*schema.Set).List()
+	if v, ok := d.GetOk("id_token_ttl"); ok {
+		data["id_token_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("access_token_ttl"); ok {
+		data["access_token_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("client_type"); ok {
+		data["client_type"] = v.(string)
+	}
+}
+
+func identityOidcClientCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	path := identityOidcClientPath(name)
+
+	data := make(map[string]interface{})
+
+	identityOidcClientUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcClient %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcClient %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcClientRead(d, meta)
+}
+
+func identityOidcClientUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+	log.Printf("[DEBUG] Updating IdentityOidcClient %s at %s", name, path)
+
+	data := map[string]interface{}{}
+
+	identityOidcClientUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error updating IdentityOidcClient %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityOidcClient %q", name)
+
+	return identityOidcClientRead(d, meta)
+}
+
+func identityOidcClientRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcClient %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcClient %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcClient %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcClient %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"key", "redirect_uris", "assignments", "id_token_ttl", "access_token_ttl", "client_type", "client_id", "client_secret"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcClient %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcClientDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcClient %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcClient %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcClient %q", name)
+
+	return nil
+}
+
+func identityOidcClientExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+
+	log.Printf("[DEBUG] Checking if IdentityOidcClient %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if IdentityOidcClient %s exists: %q", name, err)
+	}
+	log.Printf("[DEBUG] Checked if IdentityOidcClient %q exists", name)
+
+	return resp != nil, nil
+}
+
+func identityOidcClientPath(name string) string {
+	return fmt.Sprintf(identityOidcClientPathTemplate, name)
+}
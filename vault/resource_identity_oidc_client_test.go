@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcClient_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-client")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityOidcClientCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcClientConfig_basic(name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_client.test", "name", name),
+					resource.TestCheckResourceAttr("vault_identity_oidc_client.test", "redirect_uris.0", "http://127.0.0.1:8080/callback"),
+					resource.TestCheckResourceAttr("vault_identity_oidc_client.test", "id_token_ttl", "3600"),
+					resource.TestCheckResourceAttrSet("vault_identity_oidc_client.test", "client_id"),
+					resource.TestCheckResourceAttrSet("vault_identity_oidc_client.test", "client_secret"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcClientConfig_basic(name, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_client.test", "id_token_ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      "vault_identity_oidc_client.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcClientCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_client" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("client %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcClientConfig_basic(name string, idTokenTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_assignment" "test" {
+  name = "%s-assignment"
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name = "%s"
+  redirect_uris = ["http://127.0.0.1:8080/callback"]
+  assignments = ["${vault_identity_oidc_assignment.test.name}"]
+  id_token_ttl = %d
+  access_token_ttl = 7200
+}
+`, name, name, idTokenTTL)
+}
@@ -0,0 +1,169 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcProviderPathTemplate = "identity/oidc/provider/%s"
+
+func identityOidcProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcProviderCreate,
+		Update: identityOidcProviderUpdate,
+		Read:   identityOidcProviderRead,
+		Delete: identityOidcProviderDelete,
+		Exists: identityOidcProviderExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the provider.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Specifies what will be used as the scheme://host:port component for the iss claim of ID tokens. Defaults to Vault's api_addr.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_client_ids": {
+				Type:        schema.TypeSet,
+				Description: "The client IDs that are permitted to use the provider. If empty, no clients are allowed. If '*' is provided, all clients are allowed.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"scopes_supported": {
+				Type:        schema.TypeSet,
+				Description: "The scopes available for requesting on the provider.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityOidcProviderUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	if v, ok := d.GetOk("issuer"); ok {
+		data["issuer"] = v.(string)
+	}
+	data["allowed_client_ids"] = d.Get("allowed_client_ids").(*schema.Set).List()
+	data["scopes_supported"] = d.Get("scopes_supported").(*schema.Set).List()
+}
+
+func identityOidcProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	path := identityOidcProviderPath(name)
+
+	data := make(map[string]interface{})
+
+	identityOidcProviderUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcProvider %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcProvider %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcProviderRead(d, meta)
+}
+
+func identityOidcProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+	log.Printf("[DEBUG] Updating IdentityOidcProvider %s at %s", name, path)
+
+	data := map[string]interface{}{}
+
+	identityOidcProviderUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error updating IdentityOidcProvider %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityOidcProvider %q", name)
+
+	return identityOidcProviderRead(d, meta)
+}
+
+func identityOidcProviderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcProvider %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcProvider %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcProvider %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcProvider %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"issuer", "allowed_client_ids", "scopes_supported"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcProvider %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcProvider %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcProvider %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcProvider %q", name)
+
+	return nil
+}
+
+func identityOidcProviderExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+
+	log.Printf("[DEBUG] Checking if IdentityOidcProvider %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if IdentityOidcProvider %s exists: %q", name, err)
+	}
+	log.Printf("[DEBUG] Checked if IdentityOidcProvider %q exists", name)
+
+	return resp != nil, nil
+}
+
+func identityOidcProviderPath(name string) string {
+	return fmt.Sprintf(identityOidcProviderPathTemplate, name)
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcProvider_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-provider")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityOidcProviderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcProviderConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_provider.test", "name", name),
+					resource.TestCheckResourceAttr("vault_identity_oidc_provider.test", "scopes_supported.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_oidc_provider.test", "allowed_client_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "vault_identity_oidc_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcProviderCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_provider" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("provider %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcProviderConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_assignment" "test" {
+  name = "%s-assignment"
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name = "%s-client"
+  redirect_uris = ["http://127.0.0.1:8080/callback"]
+  assignments = ["${vault_identity_oidc_assignment.test.name}"]
+}
+
+resource "vault_identity_oidc_scope" "test" {
+  name = "%s-scope"
+  template = "{\"groups\":{{identity.entity.groups.names}}}"
+}
+
+resource "vault_identity_oidc_provider" "test" {
+  name = "%s"
+  allowed_client_ids = ["${vault_identity_oidc_client.test.client_id}"]
+  scopes_supported = ["${vault_identity_oidc_scope.test.name}"]
+}
+`, name, name, name, name)
+}
@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcScopePathTemplate = "identity/oidc/scope/%s"
+
+func identityOidcScope() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcScopeCreate,
+		Update: identityOidcScopeUpdate,
+		Read:   identityOidcScopeRead,
+		Delete: identityOidcScopeDelete,
+		Exists: identityOidcScopeExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the scope. The openid scope name is reserved.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"template": {
+				Type:        schema.TypeString,
+				Description: "The template string for the scope. This may be provided as escaped JSON or base64 encoded JSON.",
+				Optional:    true,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: "A description of the scope.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func identityOidcScopeUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["template"] = d.Get("template").(string)
+	data["description"] = d.Get("description").(string)
+}
+
+func identityOidcScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	path := identityOidcScopePath(name)
+
+	data := make(map[string]interface{})
+
+	identityOidcScopeUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcScope %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcScope %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcScopeRead(d, meta)
+}
+
+func identityOidcScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcScopePath(name)
+	log.Printf("[DEBUG] Updating IdentityOidcScope %s at %s", name, path)
+
+	data := map[string]interface{}{}
+
+	identityOidcScopeUpdateFields(d, data)
+
+	_, err := client.Logical().Write(path, data)
+
+	if err != nil {
+		return fmt.Errorf("error updating IdentityOidcScope %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityOidcScope %q", name)
+
+	return identityOidcScopeRead(d, meta)
+}
+
+func identityOidcScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcScopePath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcScope %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcScope %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcScope %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcScope %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"template", "description"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcScope %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcScopePath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcScope %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcScope %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcScope %q", name)
+
+	return nil
+}
+
+func identityOidcScopeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcScopePath(name)
+
+	log.Printf("[DEBUG] Checking if IdentityOidcScope %q exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if IdentityOidcScope %s exists: %q", name, err)
+	}
+	log.Printf("[DEBUG] Checked if IdentityOidcScope %q exists", name)
+
+	return resp != nil, nil
+}
+
+func identityOidcScopePath(name string) string {
+	return fmt.Sprintf(identityOidcScopePathTemplate, name)
+}
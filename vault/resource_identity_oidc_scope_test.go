@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcScope_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-scope")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccIdentityOidcScopeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcScopeConfig_basic(name, "test scope"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_scope.test", "name", name),
+					resource.TestCheckResourceAttr("vault_identity_oidc_scope.test", "description", "test scope"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcScopeConfig_basic(name, "updated scope"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_scope.test", "description", "updated scope"),
+				),
+			},
+			{
+				ResourceName:      "vault_identity_oidc_scope.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcScopeCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_scope" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("scope %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcScopeConfig_basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_scope" "test" {
+  name = "%s"
+  template = "{\"groups\":{{identity.entity.groups.names}}}"
+  description = "%s"
+}
+`, name, description)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -16,6 +17,9 @@ func jwtAuthBackendResource() *schema.Resource {
 		Delete: jwtAuthBackendDelete,
 		Read:   jwtAuthBackendRead,
 		Update: jwtAuthBackendUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		CustomizeDiff: jwtCustomizeDiff,
 
@@ -113,6 +117,12 @@ func jwtAuthBackendResource() *schema.Resource {
 				Description: "The default role to use if none is provided during login",
 			},
 
+			"provider_config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Provider specific handling configuration",
+			},
+
 			"accessor": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -151,8 +161,38 @@ var (
 		"jwt_supported_algs",
 		"default_role",
 	}
+
+	// provider_config is a TypeMap, so all of its values arrive as strings;
+	// these parameters must be converted back to the types the provider
+	// handlers expect before being written to Vault.
+	jwtAuthBooleanConfigFields = map[string]bool{"fetch_groups": true, "fetch_user_info": true}
+	jwtAuthIntegerConfigFields = map[string]bool{"groups_recurse_max_depth": true}
 )
 
+func convertProviderConfigValues(input map[string]interface{}) (map[string]interface{}, error) {
+	newConfig := make(map[string]interface{})
+	for k, v := range input {
+		val := fmt.Sprintf("%v", v)
+		switch {
+		case jwtAuthBooleanConfigFields[k]:
+			valBool, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert provider config value %q for key %q to bool", val, k)
+			}
+			newConfig[k] = valBool
+		case jwtAuthIntegerConfigFields[k]:
+			valInt, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert provider config value %q for key %q to int", val, k)
+			}
+			newConfig[k] = valInt
+		default:
+			newConfig[k] = val
+		}
+	}
+	return newConfig, nil
+}
+
 func jwtAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -219,11 +259,21 @@ func jwtAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("path", path)
 	d.Set("accessor", backend.Accessor)
 	for _, configOption := range matchingJwtMountConfigOptions {
 		d.Set(configOption, config.Data[configOption])
 	}
 
+	if v, ok := config.Data["provider_config"]; ok {
+		// flatten the values back to strings so they match the TypeMap schema
+		providerConfig := make(map[string]interface{})
+		for k, val := range v.(map[string]interface{}) {
+			providerConfig[k] = fmt.Sprintf("%v", val)
+		}
+		d.Set("provider_config", providerConfig)
+	}
+
 	return nil
 
 }
@@ -242,6 +292,14 @@ func jwtAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("provider_config"); ok {
+		providerConfig, err := convertProviderConfigValues(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		configuration["provider_config"] = providerConfig
+	}
+
 	_, err := client.Logical().Write(jwtConfigEndpoint(path), configuration)
 	if err != nil {
 		return fmt.Errorf("error updating configuration to Vault for path %s: %s", path, err)
@@ -255,7 +313,7 @@ func jwtAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			err := authMountTune(client, "auth/"+path, raw)
 			if err != nil {
-				return nil
+				return fmt.Errorf("error tuning auth mount %q: %s", path, err)
 			}
 
 			log.Printf("[INFO] Written %s auth tune to '%q'", backendType, path)
@@ -289,5 +347,9 @@ func getJwtAuthBackendIfPresent(client *api.Client, path string) (*api.AuthMount
 }
 
 func getJwtPath(d *schema.ResourceData) string {
-	return d.Get("path").(string)
+	// On import only the ID carries the path.
+	if path := d.Get("path").(string); path != "" {
+		return path
+	}
+	return d.Id()
 }
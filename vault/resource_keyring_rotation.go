@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func keyringRotationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keyringRotationCreate,
+		Read:   keyringRotationRead,
+		Delete: keyringRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key_term": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The term of the barrier key installed by this rotation.",
+			},
+		},
+	}
+}
+
+func keyringRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Rotating barrier keyring")
+	_, err := client.Logical().Write("sys/rotate", nil)
+	if err != nil {
+		return fmt.Errorf("error rotating barrier keyring: %s", err)
+	}
+	log.Printf("[DEBUG] Rotated barrier keyring")
+
+	term, err := keyringRotationReadTerm(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", term))
+	d.Set("key_term", term)
+
+	return nil
+}
+
+func keyringRotationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	term, err := keyringRotationReadTerm(client)
+	if err != nil {
+		return err
+	}
+
+	// Later rotations install newer key terms; the rotation triggered by this
+	// resource remains a historical fact, so only the current term is updated.
+	d.Set("key_term", term)
+
+	return nil
+}
+
+func keyringRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A rotation cannot be undone; deleting the resource only forgets it.
+	return nil
+}
+
+func keyringRotationReadTerm(client *api.Client) (int64, error) {
+	resp, err := client.Logical().Read("sys/key-status")
+	if err != nil {
+		return 0, fmt.Errorf("error reading key status: %s", err)
+	}
+	if resp == nil {
+		return 0, fmt.Errorf("no key status found")
+	}
+
+	term, ok := resp.Data["term"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("unexpected key status term: %v", resp.Data["term"])
+	}
+
+	return term.Int64()
+}
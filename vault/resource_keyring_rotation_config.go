@@ -0,0 +1,130 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const keyringRotationConfigPath = "sys/rotate/config"
+
+// Defaults used to restore the automatic rotation configuration on delete.
+const (
+	keyringRotationDefaultEnabled       = true
+	keyringRotationDefaultMaxOperations = 3865470566
+	keyringRotationDefaultInterval      = "0"
+)
+
+func keyringRotationConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keyringRotationConfigWrite,
+		Update: keyringRotationConfigWrite,
+		Read:   keyringRotationConfigRead,
+		Delete: keyringRotationConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether automatic rotation of the barrier keyring is enabled.",
+			},
+			"max_operations": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of encryption operations performed before the barrier key is automatically rotated.",
+			},
+			"interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The time interval between automatic rotations of the barrier key. Set to 0 to rotate only based on max_operations.",
+			},
+		},
+	}
+}
+
+func keyringRotationConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOk("max_operations"); ok {
+		data["max_operations"] = v.(int)
+	}
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing keyring rotation config %q", keyringRotationConfigPath)
+	_, err := client.Logical().Write(keyringRotationConfigPath, data)
+	if err != nil {
+		return fmt.Errorf("error writing keyring rotation config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote keyring rotation config %q", keyringRotationConfigPath)
+
+	d.SetId(keyringRotationConfigPath)
+
+	return keyringRotationConfigRead(d, meta)
+}
+
+func keyringRotationConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading keyring rotation config %q", keyringRotationConfigPath)
+	resp, err := client.Logical().Read(keyringRotationConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading keyring rotation config: %s", err)
+	}
+	log.Printf("[DEBUG] Read keyring rotation config %q", keyringRotationConfigPath)
+
+	if resp == nil {
+		log.Printf("[WARN] Keyring rotation config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := resp.Data["enabled"].(bool); ok {
+		d.Set("enabled", v)
+	}
+	if v, ok := resp.Data["max_operations"].(json.Number); ok {
+		maxOperations, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing max_operations %q: %s", v, err)
+		}
+		d.Set("max_operations", maxOperations)
+	}
+	if v, ok := resp.Data["interval"]; ok {
+		d.Set("interval", fmt.Sprintf("%v", v))
+	}
+
+	return nil
+}
+
+func keyringRotationConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// The rotation configuration cannot be removed, so deleting the resource
+	// restores the default configuration.
+	data := map[string]interface{}{
+		"enabled":        keyringRotationDefaultEnabled,
+		"max_operations": keyringRotationDefaultMaxOperations,
+		"interval":       keyringRotationDefaultInterval,
+	}
+
+	log.Printf("[DEBUG] Restoring default keyring rotation config %q", keyringRotationConfigPath)
+	_, err := client.Logical().Write(keyringRotationConfigPath, data)
+	if err != nil {
+		return fmt.Errorf("error restoring default keyring rotation config: %s", err)
+	}
+	log.Printf("[DEBUG] Restored default keyring rotation config %q", keyringRotationConfigPath)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKeyringRotationConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyringRotationConfigConfig_basic("8640000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_keyring_rotation_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("vault_keyring_rotation_config.test", "interval", "8640000"),
+				),
+			},
+			{
+				Config: testAccKeyringRotationConfigConfig_basic("4320000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_keyring_rotation_config.test", "interval", "4320000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyringRotationConfigConfig_basic(interval string) string {
+	return fmt.Sprintf(`
+resource "vault_keyring_rotation_config" "test" {
+  enabled = true
+  interval = "%s"
+}
+`, interval)
+}
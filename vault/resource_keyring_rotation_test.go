@@ -0,0 +1,26 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKeyringRotation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyringRotationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_keyring_rotation.test", "key_term"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKeyringRotationConfig_basic = `
+resource "vault_keyring_rotation" "test" {}
+`
@@ -0,0 +1,251 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretBackendCreate,
+		Read:   kmipSecretBackendRead,
+		Update: kmipSecretBackendUpdate,
+		Delete: kmipSecretBackendDelete,
+		Exists: kmipSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kmip",
+				Description: "Where the KMIP secret backend will be mounted.",
+				StateFunc: func(s interface{}) string {
+					return strings.Trim(s.(string), "/")
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"listen_addrs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Addresses the KMIP server should listen on (host:port).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_hostnames": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Hostnames to include in the server's TLS certificate as SAN DNS names. The first will be used as the common name (CN).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_ips": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "IPs to include in the server's TLS certificate as SAN IP addresses.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tls_ca_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "CA key type, rsa or ec.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"tls_ca_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "CA key bits, valid values depend on key type.",
+			},
+			"tls_min_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum TLS version to accept.",
+			},
+			"default_tls_client_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Client certificate key type, rsa or ec.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"default_tls_client_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key bits, valid values depend on key type.",
+			},
+			"default_tls_client_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate TTL in seconds.",
+			},
+		},
+	}
+}
+
+func kmipSecretBackendConfigData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, k := range []string{"listen_addrs", "server_hostnames", "server_ips"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	for _, k := range []string{"tls_ca_key_type", "tls_min_version", "default_tls_client_key_type"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range []string{"tls_ca_key_bits", "default_tls_client_key_bits", "default_tls_client_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+	return data
+}
+
+func kmipSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting KMIP secret backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "kmip",
+		Description: description,
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted KMIP secret backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+
+	configPath := kmipSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Writing KMIP configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, kmipSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing KMIP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP configuration to %q", configPath)
+	d.Partial(false)
+
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading KMIP backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+
+	configPath := kmipSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q from Vault", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP configuration from %q: %s", configPath, err)
+	}
+
+	if resp != nil {
+		for _, k := range []string{"listen_addrs", "server_hostnames", "server_ips", "tls_ca_key_type", "tls_ca_key_bits", "tls_min_version", "default_tls_client_key_type", "default_tls_client_key_bits", "default_tls_client_ttl"} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	configPath := kmipSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Updating KMIP configuration at %q", configPath)
+	if _, err := client.Logical().Write(configPath, kmipSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing KMIP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated KMIP configuration at %q", configPath)
+
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting KMIP backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		return fmt.Errorf("error unmounting KMIP backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted KMIP backend %q", path)
+	return nil
+}
+
+func kmipSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if KMIP backend exists at %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if KMIP backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func kmipSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretBackend_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	path := acctest.RandomWithPrefix("tf-test-kmip")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretBackendConfig_basic(path, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "listen_addrs.0", "127.0.0.1:5696"),
+				),
+			},
+			{
+				Config: testAccKMIPSecretBackendConfig_basic(path, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretBackendConfig_basic(path, description string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path = "%s"
+  description = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+`, path, description)
+}
@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretCredentialResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretCredentialCreate,
+		Read:   kmipSecretCredentialRead,
+		Delete: kmipSecretCredentialDelete,
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the role to generate the credential against.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the scope the role belongs to.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kmip",
+				Description: "The mount path for the KMIP secret backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format to return the certificate, private key, and CA chain in.",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "pem_bundle", "der"}, false),
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The generated client certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key for the client certificate.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the client certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the client certificate.",
+			},
+		},
+	}
+}
+
+func kmipSecretCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	role := d.Get("role").(string)
+	path := kmipSecretRolePath(backend, scope, role) + "/credential/generate"
+
+	log.Printf("[DEBUG] Generating credential at %q", path)
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"format": d.Get("format").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error generating credential at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated credential at %q", path)
+	if secret == nil {
+		return fmt.Errorf("no credential returned from %q", path)
+	}
+
+	serialNumber, ok := secret.Data["serial_number"]
+	if !ok {
+		return fmt.Errorf("no serial_number returned from %q", path)
+	}
+
+	d.SetId(fmt.Sprintf("%s", serialNumber))
+	d.Set("certificate", secret.Data["certificate"])
+	d.Set("private_key", secret.Data["private_key"])
+	d.Set("ca_chain", secret.Data["ca_chain"])
+	d.Set("serial_number", serialNumber)
+
+	return kmipSecretCredentialRead(d, meta)
+}
+
+func kmipSecretCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	role := d.Get("role").(string)
+	path := kmipSecretRolePath(backend, scope, role) + "/credential/lookup"
+
+	log.Printf("[DEBUG] Looking up credential %q at %q", d.Id(), path)
+	secret, err := client.Logical().ReadWithData(path, map[string][]string{
+		"serial_number": {d.Id()},
+	})
+	if err != nil {
+		return fmt.Errorf("error looking up credential %q at %q: %s", d.Id(), path, err)
+	}
+	log.Printf("[DEBUG] Looked up credential %q at %q", d.Id(), path)
+	if secret == nil {
+		log.Printf("[WARN] Credential %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the private key is only returned at generation time, so the lookup is
+	// just used to detect revocation; only the certificate is refreshed.
+	d.Set("certificate", secret.Data["certificate"])
+
+	return nil
+}
+
+func kmipSecretCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	role := d.Get("role").(string)
+	path := kmipSecretRolePath(backend, scope, role) + "/credential/revoke"
+
+	log.Printf("[DEBUG] Revoking credential %q at %q", d.Id(), path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"serial_number": d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("error revoking credential %q at %q: %s", d.Id(), path, err)
+	}
+	log.Printf("[DEBUG] Revoked credential %q at %q", d.Id(), path)
+	return nil
+}
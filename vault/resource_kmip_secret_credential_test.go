@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKMIPSecretCredential_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	scope := acctest.RandomWithPrefix("scope")
+	role := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretCredentialConfig_basic(backend, scope, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_credential.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_kmip_secret_credential.test", "scope", scope),
+					resource.TestCheckResourceAttr("vault_kmip_secret_credential.test", "role", role),
+					resource.TestCheckResourceAttr("vault_kmip_secret_credential.test", "format", "pem"),
+					resource.TestCheckResourceAttrSet("vault_kmip_secret_credential.test", "certificate"),
+					resource.TestCheckResourceAttrSet("vault_kmip_secret_credential.test", "private_key"),
+					resource.TestCheckResourceAttrSet("vault_kmip_secret_credential.test", "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretCredentialConfig_basic(backend, scope, role string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "%s"
+  force = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "${vault_kmip_secret_scope.test.scope}"
+  role = "%s"
+  operation_activate = true
+  operation_get = true
+  operation_get_attributes = true
+}
+
+resource "vault_kmip_secret_credential" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "${vault_kmip_secret_scope.test.scope}"
+  role = "${vault_kmip_secret_role.test.role}"
+  format = "pem"
+}
+`, backend, scope, role)
+}
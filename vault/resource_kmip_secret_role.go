@@ -0,0 +1,248 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kmipSecretRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/scope/.+/role/.+$")
+	kmipSecretRoleScopeFromPathRegex   = regexp.MustCompile("^.+/scope/(.+)/role/.+$")
+	kmipSecretRoleNameFromPathRegex    = regexp.MustCompile("^.+/scope/.+/role/(.+$)")
+
+	kmipSecretRoleOperationFields = []string{
+		"operation_activate",
+		"operation_add_attribute",
+		"operation_all",
+		"operation_create",
+		"operation_destroy",
+		"operation_discover_versions",
+		"operation_get",
+		"operation_get_attribute_list",
+		"operation_get_attributes",
+		"operation_locate",
+		"operation_none",
+		"operation_register",
+		"operation_rekey",
+		"operation_revoke",
+	}
+)
+
+func kmipSecretRoleResource() *schema.Resource {
+	resource := &schema.Resource{
+		Create: kmipSecretRoleWrite,
+		Read:   kmipSecretRoleRead,
+		Update: kmipSecretRoleWrite,
+		Delete: kmipSecretRoleDelete,
+		Exists: kmipSecretRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the role.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the scope the role belongs to.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kmip",
+				Description: "The mount path for the KMIP secret backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"tls_client_key_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key type, rsa or ec. Overrides the backend default.",
+			},
+			"tls_client_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key bits, valid values depend on key type. Overrides the backend default.",
+			},
+			"tls_client_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate TTL in seconds. Overrides the backend default.",
+			},
+		},
+	}
+
+	for _, op := range kmipSecretRoleOperationFields {
+		resource.Schema[op] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: fmt.Sprintf("Grant the %s operation to clients using this role.", strings.TrimPrefix(op, "operation_")),
+		}
+	}
+
+	return resource
+}
+
+func kmipSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	role := d.Get("role").(string)
+	path := kmipSecretRolePath(backend, scope, role)
+
+	data := map[string]interface{}{}
+	for _, op := range kmipSecretRoleOperationFields {
+		data[op] = d.Get(op).(bool)
+	}
+	if v, ok := d.GetOk("tls_client_key_type"); ok {
+		data["tls_client_key_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("tls_client_key_bits"); ok {
+		data["tls_client_key_bits"] = v.(int)
+	}
+	if v, ok := d.GetOk("tls_client_ttl"); ok {
+		data["tls_client_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing role %q in scope %q on KMIP backend %q", role, scope, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q in scope %q on KMIP backend %q", role, scope, backend)
+
+	d.SetId(path)
+	return kmipSecretRoleRead(d, meta)
+}
+
+func kmipSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	role, err := kmipSecretRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing KMIP role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	scope, err := kmipSecretRoleScopeFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing KMIP role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := kmipSecretRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing KMIP role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role)
+	d.Set("scope", scope)
+	d.Set("backend", backend)
+	for _, k := range append([]string{"tls_client_key_type", "tls_client_key_bits", "tls_client_ttl"}, kmipSecretRoleOperationFields...) {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func kmipSecretRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func kmipSecretRolePath(backend, scope, role string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/") + "/role/" + strings.Trim(role, "/")
+}
+
+func kmipSecretRoleNameFromPath(path string) (string, error) {
+	if !kmipSecretRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := kmipSecretRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretRoleScopeFromPath(path string) (string, error) {
+	if !kmipSecretRoleScopeFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no scope found")
+	}
+	res := kmipSecretRoleScopeFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for scope", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretRoleBackendFromPath(path string) (string, error) {
+	if !kmipSecretRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kmipSecretRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretRole_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	scope := acctest.RandomWithPrefix("scope")
+	role := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretRoleConfig_basic(backend, scope, role, "86400"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "scope", scope),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "role", role),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_activate", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_get", "true"),
+				),
+			},
+			{
+				Config: testAccKMIPSecretRoleConfig_basic(backend, scope, role, "43200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "tls_client_ttl", "43200"),
+				),
+			},
+			{
+				ResourceName:      "vault_kmip_secret_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretRoleConfig_basic(backend, scope, role, ttl string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "%s"
+  force = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "${vault_kmip_secret_scope.test.scope}"
+  role = "%s"
+  tls_client_ttl = %s
+  operation_activate = true
+  operation_get = true
+  operation_get_attributes = true
+}
+`, backend, scope, role, ttl)
+}
@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kmipSecretScopeBackendFromPathRegex = regexp.MustCompile("^(.+)/scope/.+$")
+	kmipSecretScopeNameFromPathRegex    = regexp.MustCompile("^.+/scope/(.+$)")
+)
+
+func kmipSecretScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretScopeCreate,
+		Read:   kmipSecretScopeRead,
+		Delete: kmipSecretScopeDelete,
+		Exists: kmipSecretScopeExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the scope.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kmip",
+				Description: "The mount path for the KMIP secret backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Force deletion even if there are managed objects in the scope.",
+			},
+		},
+	}
+}
+
+func kmipSecretScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	path := kmipSecretScopePath(backend, scope)
+
+	log.Printf("[DEBUG] Creating scope %q on KMIP backend %q", scope, backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("error creating scope %q for backend %q: %s", scope, backend, err)
+	}
+	log.Printf("[DEBUG] Created scope %q on KMIP backend %q", scope, backend)
+
+	d.SetId(path)
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	scope, err := kmipSecretScopeNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing KMIP scope %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid scope ID %q: %s", path, err)
+	}
+
+	backend, err := kmipSecretScopeBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing KMIP scope %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid scope ID %q: %s", path, err)
+	}
+
+	// the scope endpoint itself cannot be read, so we list the scopes on the
+	// backend and check for ours.
+	listPath := strings.Trim(backend, "/") + "/scope"
+	log.Printf("[DEBUG] Listing scopes on %q", listPath)
+	resp, err := client.Logical().List(listPath)
+	if err != nil {
+		return fmt.Errorf("error listing scopes on %q: %s", listPath, err)
+	}
+	log.Printf("[DEBUG] Listed scopes on %q", listPath)
+
+	found := false
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				if k == scope {
+					found = true
+					break
+				}
+			}
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("scope", scope)
+	d.Set("backend", backend)
+
+	return nil
+}
+
+func kmipSecretScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting scope %q", path)
+	_, err := client.Logical().DeleteWithData(path, map[string][]string{
+		"force": {strconv.FormatBool(d.Get("force").(bool))},
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted scope %q", path)
+	return nil
+}
+
+func kmipSecretScopeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := kmipSecretScopeBackendFromPath(path)
+	if err != nil {
+		return true, fmt.Errorf("invalid scope ID %q: %s", path, err)
+	}
+	scope, err := kmipSecretScopeNameFromPath(path)
+	if err != nil {
+		return true, fmt.Errorf("invalid scope ID %q: %s", path, err)
+	}
+
+	listPath := strings.Trim(backend, "/") + "/scope"
+	log.Printf("[DEBUG] Checking if scope %q exists", path)
+	resp, err := client.Logical().List(listPath)
+	if err != nil {
+		return true, fmt.Errorf("error checking if scope %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if scope %q exists", path)
+
+	if resp == nil {
+		return false, nil
+	}
+	if keys, ok := resp.Data["keys"].([]interface{}); ok {
+		for _, k := range keys {
+			if k == scope {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func kmipSecretScopePath(backend, scope string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/")
+}
+
+func kmipSecretScopeNameFromPath(path string) (string, error) {
+	if !kmipSecretScopeNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no scope found")
+	}
+	res := kmipSecretScopeNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for scope", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretScopeBackendFromPath(path string) (string, error) {
+	if !kmipSecretScopeBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kmipSecretScopeBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
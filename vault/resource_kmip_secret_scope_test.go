@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretScope_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	scope := acctest.RandomWithPrefix("scope")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretScopeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretScopeConfig_basic(backend, scope),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_scope.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_kmip_secret_scope.test", "scope", scope),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretScopeCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_scope" {
+			continue
+		}
+		backend := rs.Primary.Attributes["backend"]
+		scopes, err := client.Logical().List(backend + "/scope")
+		if err != nil {
+			return err
+		}
+		if scopes == nil {
+			continue
+		}
+		for _, v := range scopes.Data["keys"].([]interface{}) {
+			if v.(string) == rs.Primary.Attributes["scope"] {
+				return fmt.Errorf("scope %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretScopeConfig_basic(backend, scope string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope = "%s"
+  force = true
+}
+`, backend, scope)
+}
@@ -0,0 +1,240 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kubernetesSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendCreate,
+		Read:   kubernetesSecretBackendRead,
+		Update: kubernetesSecretBackendUpdate,
+		Delete: kubernetesSecretBackendDelete,
+		Exists: kubernetesSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kubernetes",
+				Description: "Where the secret backend will be mounted.",
+				StateFunc: func(s interface{}) string {
+					return strings.Trim(s.(string), "/")
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"kubernetes_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Kubernetes API URL to connect to. Required if the standard pod environment variables KUBERNETES_SERVICE_HOST or KUBERNETES_SERVICE_PORT are not set on the host that Vault is running on.",
+			},
+			"kubernetes_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A PEM-encoded CA certificate used by the secret backend to verify the Kubernetes API server certificate. Defaults to the local pod's CA if found, or otherwise the host's root CA set.",
+			},
+			"service_account_jwt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The JSON web token of the service account used by the secrets engine to manage Kubernetes credentials. Defaults to the local pod's JWT if found.",
+			},
+			"disable_local_ca_jwt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable defaulting to the local CA certificate and service account JWT when running in a Kubernetes pod.",
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendConfigData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"disable_local_ca_jwt": d.Get("disable_local_ca_jwt").(bool),
+	}
+	if v, ok := d.GetOk("kubernetes_host"); ok {
+		data["kubernetes_host"] = v.(string)
+	}
+	if v, ok := d.GetOk("kubernetes_ca_cert"); ok {
+		data["kubernetes_ca_cert"] = v.(string)
+	}
+	if v, ok := d.GetOk("service_account_jwt"); ok {
+		data["service_account_jwt"] = v.(string)
+	}
+	return data
+}
+
+func kubernetesSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Kubernetes secret backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "kubernetes",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted Kubernetes secret backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	configPath := kubernetesSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Writing Kubernetes configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, kubernetesSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing Kubernetes configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kubernetes configuration to %q", configPath)
+	d.Partial(false)
+
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading Kubernetes backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := kubernetesSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q from Vault", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Kubernetes configuration from %q: %s", configPath, err)
+	}
+
+	// service_account_jwt is not returned by the API, so drift on it cannot
+	// be detected.
+	if resp != nil {
+		for _, k := range []string{"kubernetes_host", "kubernetes_ca_cert", "disable_local_ca_jwt"} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
+		if err := client.Sys().TuneMount(path, config); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+		}
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+	}
+
+	configPath := kubernetesSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Updating Kubernetes configuration at %q", configPath)
+	if _, err := client.Logical().Write(configPath, kubernetesSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing Kubernetes configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated Kubernetes configuration at %q", configPath)
+	d.Partial(false)
+
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Kubernetes backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		return fmt.Errorf("error unmounting Kubernetes backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted Kubernetes backend %q", path)
+	return nil
+}
+
+func kubernetesSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if Kubernetes backend exists at %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Kubernetes backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func kubernetesSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
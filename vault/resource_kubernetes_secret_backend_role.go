@@ -0,0 +1,257 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kubernetesSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	kubernetesSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+)
+
+func kubernetesSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendRoleWrite,
+		Read:   kubernetesSecretBackendRoleRead,
+		Update: kubernetesSecretBackendRoleWrite,
+		Delete: kubernetesSecretBackendRoleDelete,
+		Exists: kubernetesSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "kubernetes",
+				Description: "The mount path for the Kubernetes secret backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"allowed_kubernetes_namespaces": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of Kubernetes namespaces this role can generate credentials for. If set to '*' all namespaces are allowed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_account_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The pre-existing service account to generate tokens for. Mutually exclusive with all role or rule bindings. If set, only a Kubernetes token will be created when credentials are requested.",
+				ConflictsWith: []string{"kubernetes_role_name", "generated_role_rules"},
+			},
+			"kubernetes_role_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The pre-existing Role or ClusterRole to bind a generated service account to. If set, Kubernetes token, service account, and role binding objects will be created when credentials are requested.",
+				ConflictsWith: []string{"service_account_name", "generated_role_rules"},
+			},
+			"generated_role_rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The Role or ClusterRole rules to use when generating a role. Accepts either JSON or YAML formatted rules. If set, the entire chain of Kubernetes objects will be generated when credentials are requested.",
+				ConflictsWith: []string{"service_account_name", "kubernetes_role_name"},
+			},
+			"kubernetes_role_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Role",
+				Description:  "Specifies whether the Kubernetes role is a Role or ClusterRole.",
+				ValidateFunc: validation.StringInSlice([]string{"Role", "ClusterRole"}, false),
+			},
+			"name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name template to use when generating service accounts, roles and role bindings. If unset, a default template is used.",
+			},
+			"token_default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The default TTL for generated Kubernetes tokens in seconds.",
+			},
+			"token_max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum TTL for generated Kubernetes tokens in seconds.",
+			},
+			"extra_annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional annotations to apply to all generated Kubernetes objects.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extra_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional labels to apply to all generated Kubernetes objects.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := kubernetesSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"allowed_kubernetes_namespaces": d.Get("allowed_kubernetes_namespaces"),
+		"kubernetes_role_type":          d.Get("kubernetes_role_type").(string),
+	}
+	if v, ok := d.GetOk("service_account_name"); ok {
+		data["service_account_name"] = v.(string)
+	}
+	if v, ok := d.GetOk("kubernetes_role_name"); ok {
+		data["kubernetes_role_name"] = v.(string)
+	}
+	if v, ok := d.GetOk("generated_role_rules"); ok {
+		data["generated_role_rules"] = v.(string)
+	}
+	if v, ok := d.GetOk("name_template"); ok {
+		data["name_template"] = v.(string)
+	}
+	if v, ok := d.GetOk("token_default_ttl"); ok {
+		data["token_default_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("token_max_ttl"); ok {
+		data["token_max_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("extra_annotations"); ok {
+		data["extra_annotations"] = v
+	}
+	if v, ok := d.GetOk("extra_labels"); ok {
+		data["extra_labels"] = v
+	}
+
+	log.Printf("[DEBUG] Writing role %q on Kubernetes backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on Kubernetes backend %q", name, backend)
+
+	d.SetId(path)
+	return kubernetesSecretBackendRoleRead(d, meta)
+}
+
+func kubernetesSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := kubernetesSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Kubernetes role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := kubernetesSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Kubernetes role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"allowed_kubernetes_namespaces", "service_account_name", "kubernetes_role_name", "generated_role_rules", "kubernetes_role_type", "name_template", "token_default_ttl", "token_max_ttl", "extra_annotations", "extra_labels"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func kubernetesSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func kubernetesSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func kubernetesSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := kubernetesSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func kubernetesSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kubernetesSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
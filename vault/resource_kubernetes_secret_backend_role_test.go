@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKubernetesSecretBackendRole_basic(t *testing.T) {
+	host, jwt := getTestKubernetesCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig_basic(backend, host, jwt, name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "allowed_kubernetes_namespaces.0", "default"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "service_account_name", "default"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_default_ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig_basic(backend, host, jwt, name, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_default_ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_kubernetes_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesSecretBackendRoleConfig_basic(backend, host, jwt, name string, tokenDefaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path = "%s"
+  kubernetes_host = "%s"
+  service_account_jwt = "%s"
+  disable_local_ca_jwt = true
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend = "${vault_kubernetes_secret_backend.test.path}"
+  name = "%s"
+  allowed_kubernetes_namespaces = ["default"]
+  service_account_name = "default"
+  token_default_ttl = %d
+}
+`, backend, host, jwt, name, tokenDefaultTTL)
+}
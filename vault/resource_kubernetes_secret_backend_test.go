@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestKubernetesCreds(t *testing.T) (string, string) {
+	host := os.Getenv("KUBERNETES_HOST")
+	jwt := os.Getenv("KUBERNETES_JWT")
+	if host == "" {
+		t.Skip("KUBERNETES_HOST not set")
+	}
+	if jwt == "" {
+		t.Skip("KUBERNETES_JWT not set")
+	}
+	return host, jwt
+}
+
+func TestAccKubernetesSecretBackend_basic(t *testing.T) {
+	host, jwt := getTestKubernetesCreds(t)
+	path := acctest.RandomWithPrefix("tf-test-kubernetes")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendConfig_basic(path, host, jwt, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "kubernetes_host", host),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretBackendConfig_basic(path, host, jwt, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesSecretBackendConfig_basic(path, host, jwt, description string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path = "%s"
+  description = "%s"
+  kubernetes_host = "%s"
+  service_account_jwt = "%s"
+  disable_local_ca_jwt = true
+}
+`, path, description, host, jwt)
+}
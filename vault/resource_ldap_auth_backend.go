@@ -67,6 +67,16 @@ func ldapAuthBackendResource() *schema.Resource {
 				return strings.ToLower(v.(string))
 			},
 		},
+		"userfilter": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"username_as_alias": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
 		"discoverdn": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -212,6 +222,14 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["userattr"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("userfilter"); ok {
+		data["userfilter"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("username_as_alias"); ok {
+		data["username_as_alias"] = v.(bool)
+	}
+
 	if v, ok := d.GetOkExists("discoverdn"); ok {
 		data["discoverdn"] = v.(bool)
 	}
@@ -301,6 +319,8 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("binddn", resp.Data["binddn"])
 	d.Set("userdn", resp.Data["userdn"])
 	d.Set("userattr", resp.Data["userattr"])
+	d.Set("userfilter", resp.Data["userfilter"])
+	d.Set("username_as_alias", resp.Data["username_as_alias"])
 	d.Set("discoverdn", resp.Data["discoverdn"])
 	d.Set("deny_null_bind", resp.Data["deny_null_bind"])
 	d.Set("upndomain", resp.Data["upndomain"])
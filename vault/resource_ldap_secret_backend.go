@@ -0,0 +1,294 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendCreate,
+		Read:   ldapSecretBackendRead,
+		Update: ldapSecretBackendUpdate,
+		Delete: ldapSecretBackendDelete,
+		Exists: ldapSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The path where the LDAP secrets backend is mounted.",
+				StateFunc: func(s interface{}) string {
+					return strings.Trim(s.(string), "/")
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"binddn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name of object to bind when performing user and group search.",
+			},
+			"bindpass": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "LDAP password for searching for the user DN.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ldap://127.0.0.1",
+				Description: "LDAP URL to connect to (default: ldap://127.0.0.1). Multiple URLs can be specified by concatenating them with commas; they will be tried in-order.",
+			},
+			"userdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN under which to perform user search.",
+			},
+			"userattr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute used for users (default: cn)",
+			},
+			"upndomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Enables userPrincipalDomain login with [username]@UPNDomain.",
+			},
+			"schema": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "openldap",
+				Description:  "The LDAP schema to use when storing entry passwords. Valid schemas include openldap, ad, and racf.",
+				ValidateFunc: validation.StringInSlice([]string{"openldap", "ad", "racf"}, false),
+			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the password policy to use to generate passwords.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying LDAP server certificate, must be x509 PEM encoded.",
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip LDAP server SSL Certificate verification - insecure and not recommended for production use.",
+			},
+			"starttls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issue a StartTLS command after establishing unencrypted connection.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendConfigData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"binddn":       d.Get("binddn").(string),
+		"bindpass":     d.Get("bindpass").(string),
+		"url":          d.Get("url").(string),
+		"schema":       d.Get("schema").(string),
+		"insecure_tls": d.Get("insecure_tls").(bool),
+	}
+	if v, ok := d.GetOk("userdn"); ok {
+		data["userdn"] = v.(string)
+	}
+	if v, ok := d.GetOk("userattr"); ok {
+		data["userattr"] = v.(string)
+	}
+	if v, ok := d.GetOk("upndomain"); ok {
+		data["upndomain"] = v.(string)
+	}
+	if v, ok := d.GetOk("password_policy"); ok {
+		data["password_policy"] = v.(string)
+	}
+	if v, ok := d.GetOk("certificate"); ok {
+		data["certificate"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("starttls"); ok {
+		data["starttls"] = v.(bool)
+	}
+	return data
+}
+
+func ldapSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting LDAP secret backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "openldap",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted LDAP secret backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	configPath := ldapSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Writing LDAP configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, ldapSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing LDAP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP configuration to %q", configPath)
+	d.Partial(false)
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading LDAP backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := ldapSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q from Vault", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP configuration from %q: %s", configPath, err)
+	}
+
+	// bindpass is not returned by the API, so drift on it cannot be
+	// detected.
+	if resp != nil {
+		for _, k := range []string{"binddn", "url", "userdn", "userattr", "upndomain", "schema", "password_policy", "certificate", "insecure_tls", "starttls"} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
+		if err := client.Sys().TuneMount(path, config); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+		}
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+	}
+
+	configPath := ldapSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Updating LDAP configuration at %q", configPath)
+	if _, err := client.Logical().Write(configPath, ldapSecretBackendConfigData(d)); err != nil {
+		return fmt.Errorf("error writing LDAP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated LDAP configuration at %q", configPath)
+	d.Partial(false)
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting LDAP backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		return fmt.Errorf("error unmounting LDAP backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted LDAP backend %q", path)
+	return nil
+}
+
+func ldapSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if LDAP backend exists at %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if LDAP backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func ldapSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,210 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendDynamicRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	ldapSecretBackendDynamicRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+$)")
+)
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendDynamicRoleWrite,
+		Read:   ldapSecretBackendDynamicRoleRead,
+		Update: ldapSecretBackendDynamicRoleWrite,
+		Delete: ldapSecretBackendDynamicRoleDelete,
+		Exists: ldapSecretBackendDynamicRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The path where the LDAP secrets backend is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"creation_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A templatized LDIF string used to create a user account. May contain multiple entries.",
+			},
+			"deletion_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A templatized LDIF string used to delete the user account once its TTL has expired. This may contain multiple LDIF entries.",
+			},
+			"rollback_ldif": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A templatized LDIF string used to attempt to rollback any changes in the event that execution of the creation_ldif results in an error. This may contain multiple LDIF entries.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template used to generate a dynamic username. This will be used to fill in the .Username field within the creation_ldif string.",
+			},
+			"default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the TTL for the leases associated with this role.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the maximum TTL for the leases associated with this role.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("role_name").(string)
+	path := ldapSecretBackendDynamicRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"creation_ldif": d.Get("creation_ldif").(string),
+		"deletion_ldif": d.Get("deletion_ldif").(string),
+	}
+	if v, ok := d.GetOk("rollback_ldif"); ok {
+		data["rollback_ldif"] = v.(string)
+	}
+	if v, ok := d.GetOk("username_template"); ok {
+		data["username_template"] = v.(string)
+	}
+	if v, ok := d.GetOk("default_ttl"); ok {
+		data["default_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing dynamic role %q on LDAP backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing dynamic role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote dynamic role %q on LDAP backend %q", name, backend)
+
+	d.SetId(path)
+	return ldapSecretBackendDynamicRoleRead(d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := ldapSecretBackendDynamicRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing LDAP dynamic role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid dynamic role ID %q: %s", path, err)
+	}
+
+	backend, err := ldapSecretBackendDynamicRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing LDAP dynamic role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid dynamic role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading dynamic role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read dynamic role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"creation_ldif", "deletion_ldif", "rollback_ldif", "username_template", "default_ttl", "max_ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting dynamic role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted dynamic role %q", path)
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func ldapSecretBackendDynamicRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendDynamicRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendDynamicRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := ldapSecretBackendDynamicRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendDynamicRoleBackendFromPath(path string) (string, error) {
+	if !ldapSecretBackendDynamicRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendDynamicRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendDynamicRole_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendDynamicRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, url, binddn, bindpass, name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "role_name", name),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "default_ttl", "3600"),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_dynamic_role.test", "creation_ldif"),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_dynamic_role.test", "deletion_ldif"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, url, binddn, bindpass, name, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "default_ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_ldap_secret_backend_dynamic_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendDynamicRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_dynamic_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("dynamic role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, url, binddn, bindpass, name string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "%s"
+  default_ttl = %d
+
+  creation_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: learn
+sn: {{.Password | utf16le | base64}}
+userPassword: {{.Password}}
+EOT
+
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+}
+`, backend, url, binddn, bindpass, name, defaultTTL)
+}
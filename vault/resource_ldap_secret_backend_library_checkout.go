@@ -0,0 +1,150 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapSecretBackendLibraryCheckoutResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendLibraryCheckoutCreate,
+		Read:   ldapSecretBackendLibraryCheckoutRead,
+		Delete: ldapSecretBackendLibraryCheckoutDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The mount path of the LDAP or Active Directory secret backend the library set belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"set": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the library set to check a service account out of.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The maximum amount of time the service account can be checked out, specified in seconds or as a Go duration format string. Defaults to the TTL configured on the library set.",
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the service account that was checked out.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current password of the checked-out service account.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendLibraryCheckoutCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	set := d.Get("set").(string)
+	path := ldapSecretBackendLibraryCheckoutPath(backend, set) + "/check-out"
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Checking service account out of library set %q on backend %q", set, backend)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error checking service account out of library set %q on backend %q: %s", set, backend, err)
+	}
+	log.Printf("[DEBUG] Checked service account out of library set %q on backend %q", set, backend)
+	if secret == nil {
+		return fmt.Errorf("no library set found at path %q", path)
+	}
+
+	serviceAccountName, ok := secret.Data["service_account_name"]
+	if !ok {
+		return fmt.Errorf("no service_account_name returned from %q", path)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", path, serviceAccountName))
+	d.Set("service_account_name", serviceAccountName)
+	d.Set("password", secret.Data["password"])
+
+	return ldapSecretBackendLibraryCheckoutRead(d, meta)
+}
+
+func ldapSecretBackendLibraryCheckoutRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	set := d.Get("set").(string)
+	serviceAccountName := d.Get("service_account_name").(string)
+	path := ldapSecretBackendLibraryCheckoutPath(backend, set) + "/status"
+
+	log.Printf("[DEBUG] Reading library set status from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading library set status from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read library set status from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Library set %q not found, removing check-out from state", set)
+		d.SetId("")
+		return nil
+	}
+
+	status, ok := secret.Data[serviceAccountName].(map[string]interface{})
+	if !ok {
+		log.Printf("[WARN] Service account %q not found in library set %q, removing check-out from state", serviceAccountName, set)
+		d.SetId("")
+		return nil
+	}
+
+	// if the account has been checked back in outside of Terraform the
+	// check-out no longer exists.
+	if available, ok := status["available"].(bool); ok && available {
+		log.Printf("[WARN] Service account %q has been checked back in, removing check-out from state", serviceAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func ldapSecretBackendLibraryCheckoutDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	set := d.Get("set").(string)
+	serviceAccountName := d.Get("service_account_name").(string)
+	path := ldapSecretBackendLibraryCheckoutPath(backend, set) + "/check-in"
+
+	log.Printf("[DEBUG] Checking service account %q back in to library set %q", serviceAccountName, set)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"service_account_names": []string{serviceAccountName},
+	})
+	if err != nil {
+		return fmt.Errorf("error checking service account %q back in to library set %q: %s", serviceAccountName, set, err)
+	}
+	log.Printf("[DEBUG] Checked service account %q back in to library set %q", serviceAccountName, set)
+	return nil
+}
+
+func ldapSecretBackendLibraryCheckoutPath(backend, set string) string {
+	return strings.Trim(backend, "/") + "/library/" + strings.Trim(set, "/")
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLDAPSecretBackendLibraryCheckout_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	serviceAccount := os.Getenv("LDAP_LIBRARY_SERVICE_ACCOUNT")
+	if serviceAccount == "" {
+		t.Skip("LDAP_LIBRARY_SERVICE_ACCOUNT not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("set")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendLibraryCheckoutConfig_basic(backend, url, binddn, bindpass, name, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_checkout.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_checkout.test", "set", name),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_library_checkout.test", "service_account_name"),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_library_checkout.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendLibraryCheckoutConfig_basic(backend, url, binddn, bindpass, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_library_set" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  name = "%s"
+  service_account_names = ["%s"]
+  ttl = 60
+}
+
+resource "vault_ldap_secret_backend_library_checkout" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  set = "${vault_ldap_secret_backend_library_set.test.name}"
+  ttl = 60
+}
+`, backend, url, binddn, bindpass, name, serviceAccount)
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendLibrarySet_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	serviceAccount := os.Getenv("LDAP_LIBRARY_SERVICE_ACCOUNT")
+	if serviceAccount == "" {
+		t.Skip("LDAP_LIBRARY_SERVICE_ACCOUNT not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("set")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendLibrarySetCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendLibrarySetConfig_basic(backend, url, binddn, bindpass, name, serviceAccount, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_set.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_set.test", "name", name),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_set.test", "service_account_names.0", serviceAccount),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_set.test", "ttl", "60"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendLibrarySetConfig_basic(backend, url, binddn, bindpass, name, serviceAccount, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_library_set.test", "ttl", "120"),
+				),
+			},
+			{
+				ResourceName:      "vault_ldap_secret_backend_library_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendLibrarySetCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_library_set" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("library set %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendLibrarySetConfig_basic(backend, url, binddn, bindpass, name, serviceAccount string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_library_set" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  name = "%s"
+  service_account_names = ["%s"]
+  ttl = %d
+}
+`, backend, url, binddn, bindpass, name, serviceAccount, ttl)
+}
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendStaticRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/static-role/.+$")
+	ldapSecretBackendStaticRoleNameFromPathRegex    = regexp.MustCompile("^.+/static-role/(.+$)")
+)
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendStaticRoleWrite,
+		Read:   ldapSecretBackendStaticRoleRead,
+		Update: ldapSecretBackendStaticRoleWrite,
+		Delete: ldapSecretBackendStaticRoleDelete,
+		Exists: ldapSecretBackendStaticRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The path where the LDAP secrets backend is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing LDAP entry to manage password rotation for.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Distinguished name (DN) of the existing LDAP entry to manage password rotation for.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "How often Vault should rotate the password of the user entry, in seconds.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("role_name").(string)
+	path := ldapSecretBackendStaticRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+	if v, ok := d.GetOk("dn"); ok {
+		data["dn"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing static role %q on LDAP backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing static role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote static role %q on LDAP backend %q", name, backend)
+
+	d.SetId(path)
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := ldapSecretBackendStaticRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing LDAP static role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	backend, err := ldapSecretBackendStaticRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing LDAP static role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading static role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read static role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"username", "dn", "rotation_period"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting static role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted static role %q", path)
+	return nil
+}
+
+func ldapSecretBackendStaticRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func ldapSecretBackendStaticRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/static-role/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendStaticRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := ldapSecretBackendStaticRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendStaticRoleBackendFromPath(path string) (string, error) {
+	if !ldapSecretBackendStaticRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendStaticRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
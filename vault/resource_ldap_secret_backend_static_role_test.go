@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendStaticRole_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	username := os.Getenv("LDAP_STATIC_USERNAME")
+	if username == "" {
+		t.Skip("LDAP_STATIC_USERNAME not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendStaticRoleConfig_basic(backend, url, binddn, bindpass, name, username, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_static_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_static_role.test", "role_name", name),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_static_role.test", "username", username),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_static_role.test", "rotation_period", "3600"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendStaticRoleConfig_basic(backend, url, binddn, bindpass, name, username, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_static_role.test", "rotation_period", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_ldap_secret_backend_static_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_static_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("static role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendStaticRoleConfig_basic(backend, url, binddn, bindpass, name, username string, rotationPeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_static_role" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role_name = "%s"
+  username = "%s"
+  rotation_period = %d
+}
+`, backend, url, binddn, bindpass, name, username, rotationPeriod)
+}
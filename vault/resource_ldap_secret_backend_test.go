@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestLDAPCreds(t *testing.T) (string, string, string) {
+	url := os.Getenv("LDAP_URL")
+	binddn := os.Getenv("LDAP_BINDDN")
+	bindpass := os.Getenv("LDAP_BINDPASS")
+	if url == "" {
+		t.Skip("LDAP_URL not set")
+	}
+	if binddn == "" {
+		t.Skip("LDAP_BINDDN not set")
+	}
+	if bindpass == "" {
+		t.Skip("LDAP_BINDPASS not set")
+	}
+	return url, binddn, bindpass
+}
+
+func TestAccLDAPSecretBackend_basic(t *testing.T) {
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	path := acctest.RandomWithPrefix("tf-test-ldap")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendConfig_basic(path, url, binddn, bindpass, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "url", url),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "binddn", binddn),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "schema", "openldap"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendConfig_basic(path, url, binddn, bindpass, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendConfig_basic(path, url, binddn, bindpass, description string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path = "%s"
+  description = "%s"
+  url = "%s"
+  binddn = "%s"
+  bindpass = "%s"
+}
+`, path, description, url, binddn, bindpass)
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const licensePath = "sys/license"
+
+func licenseResource() *schema.Resource {
+	return &schema.Resource{
+		Create: licenseWrite,
+		Update: licenseWrite,
+		Read:   licenseRead,
+		Delete: licenseDelete,
+
+		Schema: map[string]*schema.Schema{
+			"text": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The text of the license.",
+			},
+			"license_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the license.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start time of the license.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration time of the license.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The features enabled by the license.",
+			},
+		},
+	}
+}
+
+func licenseWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"text": d.Get("text").(string),
+	}
+
+	log.Printf("[DEBUG] Writing license to Vault")
+	_, err := client.Logical().Write(licensePath, data)
+	if err != nil {
+		return fmt.Errorf("error writing license: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote license to Vault")
+
+	d.SetId("license")
+
+	return licenseRead(d, meta)
+}
+
+func licenseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading license from Vault")
+	resp, err := client.Logical().Read(licensePath)
+	if err != nil {
+		return fmt.Errorf("error reading license: %s", err)
+	}
+	log.Printf("[DEBUG] Read license from Vault")
+
+	if resp == nil {
+		log.Printf("[WARN] License not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	// the license text cannot be read back out of the API, so we rely on
+	// the value in the config.
+	d.Set("license_id", resp.Data["license_id"])
+	d.Set("start_time", resp.Data["start_time"])
+	d.Set("expiration_time", resp.Data["expiration_time"])
+	d.Set("features", resp.Data["features"])
+
+	return nil
+}
+
+func licenseDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to remove a license from a running cluster, so the
+	// license is only removed from state.
+	log.Printf("[WARN] Licenses cannot be removed from Vault; removing from state only")
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLicense_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	licenseText := os.Getenv("VAULT_LICENSE_TEXT")
+	if licenseText == "" {
+		t.Skip("VAULT_LICENSE_TEXT not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConfig_basic(licenseText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_license.test", "license_id"),
+					resource.TestCheckResourceAttrSet("vault_license.test", "expiration_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLicenseConfig_basic(licenseText string) string {
+	return fmt.Sprintf(`
+resource "vault_license" "test" {
+  text = "%s"
+}
+`, licenseText)
+}
@@ -0,0 +1,390 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func managedKeysPath(keyType, name string) string {
+	return "sys/managed-keys/" + keyType + "/" + name
+}
+
+// credential fields are not returned by the API, so reads keep the values
+// from the configuration.
+var managedKeysSensitiveFields = map[string]bool{
+	"access_key":    true,
+	"secret_key":    true,
+	"client_secret": true,
+	"pin":           true,
+}
+
+func managedKeysCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A unique lowercase name that serves as identifying the key.",
+		},
+		"allow_generate_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If no existing key can be found in the referenced backend, instructs Vault to generate a key within the backend.",
+		},
+		"allow_replace_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Controls the ability for Vault to replace through generation or importing a key into the configured backend even if a key is present, if set to false those operations are forbidden if a key exists.",
+		},
+		"allow_store_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Controls the ability for Vault to import a key to the configured backend, if false, Vault will not import the key.",
+		},
+		"any_mount": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Allow usage from any mount point within the namespace if true.",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the managed key read from Vault.",
+		},
+	}
+}
+
+func managedKeysAWSSchema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["access_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The AWS access key to use.",
+	}
+	s["secret_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The AWS secret key to use.",
+	}
+	s["kms_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "An identifier for the key.",
+	}
+	s["key_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of key to use.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The size in bits for an RSA key.",
+	}
+	s["curve"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The curve to use for an ECDSA key. Required when key_type is ECDSA.",
+	}
+	s["endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Used to specify a custom AWS endpoint.",
+	}
+	s["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The AWS region where the keys are stored (or will be stored).",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysAzureSchema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The tenant id for the Azure Active Directory organization.",
+	}
+	s["client_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The client id for credentials to query the Azure APIs.",
+	}
+	s["client_secret"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The client secret for credentials to query the Azure APIs.",
+	}
+	s["environment"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The Azure Cloud environment API endpoints to use.",
+	}
+	s["vault_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The Key Vault vault to use for encryption and decryption.",
+	}
+	s["key_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The Key Vault key to use for encryption and decryption.",
+	}
+	s["resource"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The Azure Key Vault resource's DNS Suffix to connect to.",
+	}
+	s["key_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of key to use.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The size in bits for an RSA key.",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysPKCSSchema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["library"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the kms_library stanza to use from Vault's config to lookup the local library path.",
+	}
+	s["key_label"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The label of the key to use.",
+	}
+	s["key_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The id of a PKCS#11 key to use.",
+	}
+	s["mechanism"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The encryption/decryption mechanism to use, specified as a hexadecimal (prefixed by 0x) string.",
+	}
+	s["pin"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The PIN for login.",
+	}
+	s["slot"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The slot number to use, specified as a string in a decimal format (e.g. '2305843009213693953').",
+	}
+	s["token_label"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The slot token label to use.",
+	}
+	s["curve"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Supplies the curve value when using the CKM_ECDSA mechanism.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Supplies the size in bits of the key when using CKM_RSA_PKCS_PSS, CKM_RSA_PKCS_OAEP or CKM_RSA_PKCS mechanisms.",
+	}
+	s["force_rw_session"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Force all operations to open up a read-write session to the HSM.",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysResource() *schema.Resource {
+	return &schema.Resource{
+		Create: managedKeysWrite,
+		Update: managedKeysWrite,
+		Read:   managedKeysRead,
+		Delete: managedKeysDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        managedKeysAWSSchema(),
+				Description: "Configuration block for AWS KMS managed keys.",
+			},
+			"azure": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        managedKeysAzureSchema(),
+				Description: "Configuration block for Azure Key Vault managed keys.",
+			},
+			"pkcs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        managedKeysPKCSSchema(),
+				Description: "Configuration block for PKCS#11 managed keys.",
+			},
+		},
+	}
+}
+
+var managedKeysTypes = map[string]string{
+	"aws":   "awskms",
+	"azure": "azurekeyvault",
+	"pkcs":  "pkcs11",
+}
+
+func managedKeysWriteType(client *api.Client, keyType string, keys *schema.Set) error {
+	for _, raw := range keys.List() {
+		m := raw.(map[string]interface{})
+		name := m["name"].(string)
+		path := managedKeysPath(keyType, name)
+
+		data := map[string]interface{}{}
+		for k, v := range m {
+			if k == "name" || k == "uuid" {
+				continue
+			}
+			switch value := v.(type) {
+			case string:
+				if value != "" {
+					data[k] = value
+				}
+			default:
+				data[k] = v
+			}
+		}
+
+		log.Printf("[DEBUG] Writing managed key %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error writing managed key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Wrote managed key %q", path)
+	}
+	return nil
+}
+
+func managedKeysWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for block, keyType := range managedKeysTypes {
+		oldKeys, newKeys := d.GetChange(block)
+
+		// remove keys that are no longer configured before writing, so a
+		// rename does not leave the old key behind.
+		for _, raw := range oldKeys.(*schema.Set).List() {
+			oldName := raw.(map[string]interface{})["name"].(string)
+			stillPresent := false
+			for _, newRaw := range newKeys.(*schema.Set).List() {
+				if newRaw.(map[string]interface{})["name"].(string) == oldName {
+					stillPresent = true
+					break
+				}
+			}
+			if stillPresent {
+				continue
+			}
+			path := managedKeysPath(keyType, oldName)
+			log.Printf("[DEBUG] Deleting managed key %q", path)
+			if _, err := client.Logical().Delete(path); err != nil {
+				return fmt.Errorf("error deleting managed key %q: %s", path, err)
+			}
+		}
+
+		if err := managedKeysWriteType(client, keyType, newKeys.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("default")
+
+	return managedKeysRead(d, meta)
+}
+
+func managedKeysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for block, keyType := range managedKeysTypes {
+		keys := d.Get(block).(*schema.Set)
+		result := schema.NewSet(keys.F, nil)
+
+		for _, raw := range keys.List() {
+			m := raw.(map[string]interface{})
+			name := m["name"].(string)
+			path := managedKeysPath(keyType, name)
+
+			log.Printf("[DEBUG] Reading managed key %q", path)
+			resp, err := client.Logical().Read(path)
+			if err != nil {
+				return fmt.Errorf("error reading managed key %q: %s", path, err)
+			}
+
+			if resp == nil {
+				log.Printf("[WARN] Managed key %q not found, removing from state", path)
+				continue
+			}
+
+			for k, v := range resp.Data {
+				if _, ok := m[k]; !ok {
+					continue
+				}
+				if managedKeysSensitiveFields[k] {
+					continue
+				}
+				// the API returns numeric fields as json.Number, which must
+				// be coerced back to the schema's type.
+				switch m[k].(type) {
+				case string:
+					m[k] = fmt.Sprintf("%v", v)
+				case bool:
+					if b, ok := v.(bool); ok {
+						m[k] = b
+					}
+				default:
+					m[k] = v
+				}
+			}
+			result.Add(m)
+		}
+
+		if err := d.Set(block, result); err != nil {
+			return fmt.Errorf("error setting %s managed keys: %s", block, err)
+		}
+	}
+
+	return nil
+}
+
+func managedKeysDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for block, keyType := range managedKeysTypes {
+		for _, raw := range d.Get(block).(*schema.Set).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			path := managedKeysPath(keyType, name)
+
+			log.Printf("[DEBUG] Deleting managed key %q", path)
+			if _, err := client.Logical().Delete(path); err != nil {
+				return fmt.Errorf("error deleting managed key %q: %s", path, err)
+			}
+			log.Printf("[DEBUG] Deleted managed key %q", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccManagedKeys_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	accessKey, secretKey := getTestAWSCreds(t)
+	kmsKey := os.Getenv("AWS_KMS_KEY_ID")
+	if kmsKey == "" {
+		t.Skip("AWS_KMS_KEY_ID not set")
+	}
+	name := acctest.RandomWithPrefix("tf-test-key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccManagedKeysCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedKeysConfig_basic(name, accessKey, secretKey, kmsKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_managed_keys.test", "aws.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagedKeysCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_managed_keys" {
+			continue
+		}
+		keys, err := client.Logical().List("sys/managed-keys/awskms")
+		if err != nil {
+			return err
+		}
+		if keys != nil && keys.Data["keys"] != nil {
+			return fmt.Errorf("managed keys still exist")
+		}
+	}
+	return nil
+}
+
+func testAccManagedKeysConfig_basic(name, accessKey, secretKey, kmsKey string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_keys" "test" {
+  aws {
+    name = "%s"
+    access_key = "%s"
+    secret_key = "%s"
+    kms_key = "%s"
+    key_type = "RSA"
+    key_bits = "2048"
+    allow_generate_key = true
+  }
+}
+`, name, accessKey, secretKey, kmsKey)
+}
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func mongodbAtlasSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: mongodbAtlasSecretBackendCreate,
+		Read:   mongodbAtlasSecretBackendRead,
+		Update: mongodbAtlasSecretBackendUpdate,
+		Delete: mongodbAtlasSecretBackendDelete,
+		Exists: mongodbAtlasSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "mongodbatlas",
+				Description: "Path to mount the backend at.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(string)
+					if strings.HasSuffix(value, "/") {
+						errs = append(errs, fmt.Errorf("path cannot end in '/'"))
+					}
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The MongoDB Atlas programmatic API public key used to authenticate with the Atlas API.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The MongoDB Atlas programmatic API private key used to authenticate with the Atlas API.",
+			},
+		},
+	}
+}
+
+func mongodbAtlasSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting MongoDB Atlas backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "mongodbatlas",
+		Description: description,
+		Config:      api.MountConfigInput{},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted MongoDB Atlas backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+
+	configPath := mongodbAtlasSecretBackendConfigPath(path)
+	data := map[string]interface{}{
+		"public_key":  d.Get("public_key").(string),
+		"private_key": d.Get("private_key").(string),
+	}
+
+	log.Printf("[DEBUG] Writing MongoDB Atlas configuration to %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing MongoDB Atlas configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote MongoDB Atlas configuration to %q", configPath)
+	d.Partial(false)
+
+	return mongodbAtlasSecretBackendRead(d, meta)
+}
+
+func mongodbAtlasSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading MongoDB Atlas backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read MongoDB Atlas backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+
+	log.Printf("[DEBUG] Reading MongoDB Atlas secret backend config %q", path)
+	resp, err := client.Logical().Read(mongodbAtlasSecretBackendConfigPath(path))
+	if err != nil {
+		return fmt.Errorf("error reading MongoDB Atlas secret backend config: %s", err)
+	}
+	if resp != nil {
+		if v, ok := resp.Data["public_key"].(string); ok {
+			d.Set("public_key", v)
+		}
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	if d.HasChange("public_key") || d.HasChange("private_key") {
+		configPath := mongodbAtlasSecretBackendConfigPath(path)
+		data := map[string]interface{}{
+			"public_key":  d.Get("public_key").(string),
+			"private_key": d.Get("private_key").(string),
+		}
+		log.Printf("[DEBUG] Updating MongoDB Atlas configuration at %q", configPath)
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error writing MongoDB Atlas configuration for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated MongoDB Atlas configuration at %q", configPath)
+	}
+	return mongodbAtlasSecretBackendRead(d, meta)
+}
+
+func mongodbAtlasSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting MongoDB Atlas backend %q", path)
+	err := client.Sys().Unmount(path)
+	if err != nil {
+		return fmt.Errorf("error unmounting MongoDB Atlas backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted MongoDB Atlas backend %q", path)
+	return nil
+}
+
+func mongodbAtlasSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if MongoDB Atlas backend exists at %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if MongoDB Atlas backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func mongodbAtlasSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,240 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	mongodbAtlasSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	mongodbAtlasSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+)
+
+func mongodbAtlasSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: mongodbAtlasSecretBackendRoleWrite,
+		Read:   mongodbAtlasSecretBackendRoleRead,
+		Update: mongodbAtlasSecretBackendRoleWrite,
+		Delete: mongodbAtlasSecretBackendRoleDelete,
+		Exists: mongodbAtlasSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "mongodbatlas",
+				Description: "The path of the MongoDB Atlas Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the MongoDB Atlas organization the API key grants access to.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the MongoDB Atlas project the API key grants access to.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of roles that the API key should be granted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IP addresses to be added to the access list for the API key.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cidr_blocks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "CIDR blocks to be added to the access list for the API key.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"project_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Roles assigned when an organization API key is assigned to a project.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The duration in seconds after which the issued credentials should expire.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum allowed lifetime in seconds of credentials issued using this role.",
+			},
+		},
+	}
+}
+
+func mongodbAtlasSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := mongodbAtlasSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"roles": d.Get("roles"),
+	}
+	if v, ok := d.GetOk("organization_id"); ok {
+		data["organization_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("project_id"); ok {
+		data["project_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("ip_addresses"); ok {
+		data["ip_addresses"] = v
+	}
+	if v, ok := d.GetOk("cidr_blocks"); ok {
+		data["cidr_blocks"] = v
+	}
+	if v, ok := d.GetOk("project_roles"); ok {
+		data["project_roles"] = v
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing role %q on MongoDB Atlas backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on MongoDB Atlas backend %q", name, backend)
+
+	d.SetId(path)
+	return mongodbAtlasSecretBackendRoleRead(d, meta)
+}
+
+func mongodbAtlasSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := mongodbAtlasSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing MongoDB Atlas role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := mongodbAtlasSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing MongoDB Atlas role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"organization_id", "project_id", "roles", "ip_addresses", "cidr_blocks", "project_roles", "ttl", "max_ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func mongodbAtlasSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func mongodbAtlasSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func mongodbAtlasSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !mongodbAtlasSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := mongodbAtlasSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func mongodbAtlasSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !mongodbAtlasSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := mongodbAtlasSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccMongoDBAtlasSecretBackendRole_basic(t *testing.T) {
+	publicKey, privateKey := getTestMongoDBAtlasCreds(t)
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	if projectID == "" {
+		t.Skip("MONGODB_ATLAS_PROJECT_ID not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccMongoDBAtlasSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSecretBackendRoleConfig_basic(backend, publicKey, privateKey, name, projectID, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "project_id", projectID),
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "roles.0", "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasSecretBackendRoleConfig_basic(backend, publicKey, privateKey, name, projectID, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend_role.test", "ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mongodbatlas_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasSecretBackendRoleConfig_basic(backend, publicKey, privateKey, name, projectID string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path = "%s"
+  public_key = "%s"
+  private_key = "%s"
+}
+
+resource "vault_mongodbatlas_secret_backend_role" "test" {
+  backend = "${vault_mongodbatlas_secret_backend.test.path}"
+  name = "%s"
+  project_id = "%s"
+  roles = ["GROUP_READ_ONLY"]
+  ttl = %d
+}
+`, backend, publicKey, privateKey, name, projectID, ttl)
+}
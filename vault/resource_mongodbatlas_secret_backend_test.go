@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestMongoDBAtlasCreds(t *testing.T) (string, string) {
+	publicKey := os.Getenv("MONGODB_ATLAS_PUBLIC_KEY")
+	privateKey := os.Getenv("MONGODB_ATLAS_PRIVATE_KEY")
+	if publicKey == "" {
+		t.Skip("MONGODB_ATLAS_PUBLIC_KEY not set")
+	}
+	if privateKey == "" {
+		t.Skip("MONGODB_ATLAS_PRIVATE_KEY not set")
+	}
+	return publicKey, privateKey
+}
+
+func TestAccMongoDBAtlasSecretBackend_basic(t *testing.T) {
+	publicKey, privateKey := getTestMongoDBAtlasCreds(t)
+	path := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccMongoDBAtlasSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSecretBackendConfig_basic(path, publicKey, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_mongodbatlas_secret_backend.test", "description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mongodbatlas_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasSecretBackendConfig_basic(path, publicKey, privateKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  public_key = "%s"
+  private_key = "%s"
+}
+`, path, publicKey, privateKey)
+}
@@ -92,6 +92,37 @@ func mountResource() *schema.Resource {
 				Computed:    true,
 				Description: "Enable seal wrapping for the mount, causing values stored by the mount to be wrapped by the seal's encryption capability",
 			},
+
+			"audit_non_hmac_request_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the list of keys that will not be HMAC'd by audit devices in the request data object.",
+			},
+
+			"audit_non_hmac_response_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the list of keys that will not be HMAC'd by audit devices in the response data object.",
+			},
+
+			"allowed_response_headers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of headers to whitelist and allow a plugin to include them in the response.",
+			},
+
+			"allowed_managed_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of managed key registry entry names that the mount in question is allowed to access",
+			},
 		},
 	}
 }
@@ -103,8 +134,11 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 		Type:        d.Get("type").(string),
 		Description: d.Get("description").(string),
 		Config: api.MountConfigInput{
-			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
-			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+			DefaultLeaseTTL:          fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:              fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+			AuditNonHMACRequestKeys:  expandStringSlice(d.Get("audit_non_hmac_request_keys").([]interface{})),
+			AuditNonHMACResponseKeys: expandStringSlice(d.Get("audit_non_hmac_response_keys").([]interface{})),
+			AllowedResponseHeaders:   expandStringSlice(d.Get("allowed_response_headers").([]interface{})),
 		},
 		Local:    d.Get("local").(bool),
 		Options:  opts(d),
@@ -119,6 +153,17 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
+	// allowed_managed_keys is not expressible through the client's mount
+	// structs, so it is tuned through the API directly.
+	if v, ok := d.GetOk("allowed_managed_keys"); ok {
+		data := map[string]interface{}{
+			"allowed_managed_keys": expandStringSlice(v.(*schema.Set).List()),
+		}
+		if _, err := client.Logical().Write("sys/mounts/"+path+"/tune", data); err != nil {
+			return fmt.Errorf("error tuning allowed_managed_keys for mount %q: %s", path, err)
+		}
+	}
+
 	d.SetId(path)
 
 	return mountRead(d, meta)
@@ -128,9 +173,12 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	config := api.MountConfigInput{
-		DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
-		MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
-		Options:         opts(d),
+		DefaultLeaseTTL:          fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+		MaxLeaseTTL:              fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		Options:                  opts(d),
+		AuditNonHMACRequestKeys:  expandStringSlice(d.Get("audit_non_hmac_request_keys").([]interface{})),
+		AuditNonHMACResponseKeys: expandStringSlice(d.Get("audit_non_hmac_response_keys").([]interface{})),
+		AllowedResponseHeaders:   expandStringSlice(d.Get("allowed_response_headers").([]interface{})),
 	}
 
 	path := d.Id()
@@ -155,6 +203,17 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error updating Vault: %s", err)
 	}
 
+	// allowed_managed_keys is not expressible through the client's mount
+	// structs, so it is tuned through the API directly.
+	if d.HasChange("allowed_managed_keys") {
+		data := map[string]interface{}{
+			"allowed_managed_keys": expandStringSlice(d.Get("allowed_managed_keys").(*schema.Set).List()),
+		}
+		if _, err := client.Logical().Write("sys/mounts/"+path+"/tune", data); err != nil {
+			return fmt.Errorf("error tuning allowed_managed_keys for mount %q: %s", path, err)
+		}
+	}
+
 	return mountRead(d, meta)
 }
 
@@ -218,6 +277,21 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("local", mount.Local)
 	d.Set("options", mount.Options)
 	d.Set("seal_wrap", mount.SealWrap)
+	d.Set("audit_non_hmac_request_keys", mount.Config.AuditNonHMACRequestKeys)
+	d.Set("audit_non_hmac_response_keys", mount.Config.AuditNonHMACResponseKeys)
+	d.Set("allowed_response_headers", mount.Config.AllowedResponseHeaders)
+
+	// allowed_managed_keys is not exposed on the client's mount structs, so
+	// it is read from the tune endpoint directly.
+	tune, err := client.Logical().Read("sys/mounts/" + strings.Trim(path, "/") + "/tune")
+	if err != nil {
+		return fmt.Errorf("error reading tune config for mount %q: %s", path, err)
+	}
+	if tune != nil {
+		if v, ok := tune.Data["allowed_managed_keys"]; ok {
+			d.Set("allowed_managed_keys", v)
+		}
+	}
 
 	return nil
 }
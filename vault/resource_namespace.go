@@ -32,6 +32,13 @@ func namespaceResource() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the namepsace.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Description: "Custom metadata describing this namespace. Value type is map[string]string.",
+			},
 		},
 	}
 }
@@ -41,8 +48,15 @@ func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get("path").(string)
 
+	var data map[string]interface{}
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data = map[string]interface{}{
+			"custom_metadata": v.(map[string]interface{}),
+		}
+	}
+
 	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write("sys/namespaces/"+path, nil)
+	_, err := client.Logical().Write("sys/namespaces/"+path, data)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -88,6 +102,9 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(resp.Data["path"].(string))
 	d.Set("namespace_id", resp.Data["id"])
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		d.Set("custom_metadata", v)
+	}
 
 	noTrailingSlashPath := strings.TrimSuffix(path, "/")
 	d.Set("path", noTrailingSlashPath)
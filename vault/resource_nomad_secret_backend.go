@@ -0,0 +1,351 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func nomadSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: nomadSecretBackendCreate,
+		Read:   nomadSecretBackendRead,
+		Update: nomadSecretBackendUpdate,
+		Delete: nomadSecretBackendDelete,
+		Exists: nomadSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "nomad",
+				Description: "The mount path for the Nomad backend.",
+				StateFunc: func(s interface{}) string {
+					return strings.Trim(s.(string), "/")
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default lease duration for secrets in seconds.",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum possible lease duration for secrets in seconds.",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the address of the Nomad instance, provided as \"protocol://host:port\" like \"http://127.0.0.1:4646\".",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Specifies the Nomad Management token to use.",
+			},
+			"max_token_name_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the maximum length to use for the name of the Nomad token generated with Generate Credential. If omitted, 0 is used and ignored, defaulting to the max value allowed by the Nomad version.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying Nomad server certificate, must be x509 PEM encoded.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client certificate used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_key.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client key used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_cert.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the ttl of the lease for the generated token.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the maximum ttl of the lease for the generated token.",
+			},
+		},
+	}
+}
+
+func nomadSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+
+	info := &api.MountInput{
+		Type:        "nomad",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	}
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Nomad backend at %q", backend)
+
+	if err := client.Sys().Mount(backend, info); err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+
+	log.Printf("[DEBUG] Mounted Nomad backend at %q", backend)
+	d.SetId(backend)
+
+	d.SetPartial("backend")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	accessPath := nomadSecretBackendAccessConfigPath(backend)
+	log.Printf("[DEBUG] Writing Nomad access configuration to %q", accessPath)
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("address"); ok {
+		data["address"] = v.(string)
+	}
+	if v, ok := d.GetOk("token"); ok {
+		data["token"] = v.(string)
+	}
+	if v, ok := d.GetOk("max_token_name_length"); ok {
+		data["max_token_name_length"] = v.(int)
+	}
+	if v, ok := d.GetOk("ca_cert"); ok {
+		data["ca_cert"] = v.(string)
+	}
+	if v, ok := d.GetOk("client_cert"); ok {
+		data["client_cert"] = v.(string)
+	}
+	if v, ok := d.GetOk("client_key"); ok {
+		data["client_key"] = v.(string)
+	}
+	if _, err := client.Logical().Write(accessPath, data); err != nil {
+		return fmt.Errorf("error writing Nomad access configuration for %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote Nomad access configuration to %q", accessPath)
+
+	leasePath := nomadSecretBackendLeaseConfigPath(backend)
+	leaseData := map[string]interface{}{}
+	if v, ok := d.GetOk("ttl"); ok {
+		leaseData["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		leaseData["max_ttl"] = v.(int)
+	}
+	if len(leaseData) > 0 {
+		log.Printf("[DEBUG] Writing Nomad lease configuration to %q", leasePath)
+		if _, err := client.Logical().Write(leasePath, leaseData); err != nil {
+			return fmt.Errorf("error writing Nomad lease configuration for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Wrote Nomad lease configuration to %q", leasePath)
+	}
+	d.Partial(false)
+
+	return nomadSecretBackendRead(d, meta)
+}
+
+func nomadSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Reading Nomad backend mount %q from Vault", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", backend, err)
+	}
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(backend, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	accessPath := nomadSecretBackendAccessConfigPath(backend)
+	log.Printf("[DEBUG] Reading %s from Vault", accessPath)
+	secret, err := client.Logical().Read(accessPath)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	// token, client_cert and client_key can't be read back out of the API,
+	// so we can't detect drift on them.
+	if secret != nil {
+		if v, ok := secret.Data["address"]; ok {
+			d.Set("address", v)
+		}
+		if v, ok := secret.Data["max_token_name_length"]; ok {
+			d.Set("max_token_name_length", v)
+		}
+		if v, ok := secret.Data["ca_cert"]; ok {
+			d.Set("ca_cert", v)
+		}
+	}
+
+	leasePath := nomadSecretBackendLeaseConfigPath(backend)
+	log.Printf("[DEBUG] Reading %s from Vault", leasePath)
+	lease, err := client.Logical().Read(leasePath)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if lease != nil {
+		for _, k := range []string{"ttl", "max_ttl"} {
+			if v, ok := lease.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func nomadSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	d.Partial(true)
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+
+		log.Printf("[DEBUG] Updating lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, config); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+	}
+
+	if d.HasChange("address") || d.HasChange("token") || d.HasChange("max_token_name_length") || d.HasChange("ca_cert") || d.HasChange("client_cert") || d.HasChange("client_key") {
+		accessPath := nomadSecretBackendAccessConfigPath(backend)
+		log.Printf("[DEBUG] Updating Nomad access configuration at %q", accessPath)
+		data := map[string]interface{}{
+			"address": d.Get("address").(string),
+			"token":   d.Get("token").(string),
+		}
+		if v, ok := d.GetOk("max_token_name_length"); ok {
+			data["max_token_name_length"] = v.(int)
+		}
+		if v, ok := d.GetOk("ca_cert"); ok {
+			data["ca_cert"] = v.(string)
+		}
+		if v, ok := d.GetOk("client_cert"); ok {
+			data["client_cert"] = v.(string)
+		}
+		if v, ok := d.GetOk("client_key"); ok {
+			data["client_key"] = v.(string)
+		}
+		if _, err := client.Logical().Write(accessPath, data); err != nil {
+			return fmt.Errorf("error writing Nomad access configuration for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated Nomad access configuration at %q", accessPath)
+
+		d.SetPartial("address")
+		d.SetPartial("token")
+		d.SetPartial("max_token_name_length")
+		d.SetPartial("ca_cert")
+		d.SetPartial("client_cert")
+		d.SetPartial("client_key")
+	}
+
+	if d.HasChange("ttl") || d.HasChange("max_ttl") {
+		leasePath := nomadSecretBackendLeaseConfigPath(backend)
+		log.Printf("[DEBUG] Updating Nomad lease configuration at %q", leasePath)
+		data := map[string]interface{}{
+			"ttl":     d.Get("ttl").(int),
+			"max_ttl": d.Get("max_ttl").(int),
+		}
+		if _, err := client.Logical().Write(leasePath, data); err != nil {
+			return fmt.Errorf("error writing Nomad lease configuration for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated Nomad lease configuration at %q", leasePath)
+
+		d.SetPartial("ttl")
+		d.SetPartial("max_ttl")
+	}
+	d.Partial(false)
+
+	return nomadSecretBackendRead(d, meta)
+}
+
+func nomadSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Nomad backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		return fmt.Errorf("error unmounting Nomad backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted Nomad backend %q", backend)
+	return nil
+}
+
+func nomadSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Checking if Nomad backend exists at %q", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Nomad backend exists at %q", backend)
+	_, ok := mounts[strings.Trim(backend, "/")+"/"]
+	return ok, nil
+}
+
+func nomadSecretBackendAccessConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/access"
+}
+
+func nomadSecretBackendLeaseConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/lease"
+}
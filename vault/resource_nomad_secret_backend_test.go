@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestNomadCreds(t *testing.T) (string, string) {
+	address := os.Getenv("NOMAD_ADDR")
+	token := os.Getenv("NOMAD_TOKEN")
+	if address == "" {
+		t.Skip("NOMAD_ADDR not set")
+	}
+	if token == "" {
+		t.Skip("NOMAD_TOKEN not set")
+	}
+	return address, token
+}
+
+func TestAccNomadSecretBackend_basic(t *testing.T) {
+	address, token := getTestNomadCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccNomadSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadSecretBackendConfig_basic(backend, address, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "address", address),
+				),
+			},
+		},
+	})
+}
+
+func testAccNomadSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_nomad_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["backend"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNomadSecretBackendConfig_basic(backend, address, token string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  backend = "%s"
+  description = "test description"
+  address = "%s"
+  token = "%s"
+}
+`, backend, address, token)
+}
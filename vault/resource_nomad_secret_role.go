@@ -0,0 +1,200 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	nomadSecretRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	nomadSecretRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+$)")
+)
+
+func nomadSecretRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: nomadSecretRoleWrite,
+		Read:   nomadSecretRoleRead,
+		Update: nomadSecretRoleWrite,
+		Delete: nomadSecretRoleDelete,
+		Exists: nomadSecretRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "nomad",
+				Description: "The mount path for the Nomad backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "client",
+				Description:  "Specifies the type of token to create when using this role. Valid values are \"client\" or \"management\".",
+				ValidateFunc: validation.StringInSlice([]string{"client", "management"}, false),
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Comma separated list of Nomad policies the token is going to be created against. These need to be created beforehand in Nomad. Required for client tokens.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"global": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies if the token should be global.",
+			},
+		},
+	}
+}
+
+func nomadSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := nomadSecretRolePath(backend, role)
+
+	tokenType := d.Get("type").(string)
+	policies := d.Get("policies").([]interface{})
+
+	if tokenType == "client" && len(policies) == 0 {
+		return fmt.Errorf("policies are required when token type is %q", tokenType)
+	}
+
+	data := map[string]interface{}{
+		"type": tokenType,
+	}
+	if len(policies) > 0 {
+		data["policies"] = policies
+	}
+	if v, ok := d.GetOkExists("global"); ok {
+		data["global"] = v.(bool)
+	}
+
+	log.Printf("[DEBUG] Writing role %q on Nomad backend %q", role, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on Nomad backend %q", role, backend)
+
+	d.SetId(path)
+	return nomadSecretRoleRead(d, meta)
+}
+
+func nomadSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	role, err := nomadSecretRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Nomad role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := nomadSecretRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Nomad role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role)
+	d.Set("backend", backend)
+	for _, k := range []string{"type", "policies", "global"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func nomadSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func nomadSecretRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func nomadSecretRolePath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(role, "/")
+}
+
+func nomadSecretRoleNameFromPath(path string) (string, error) {
+	if !nomadSecretRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := nomadSecretRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func nomadSecretRoleBackendFromPath(path string) (string, error) {
+	if !nomadSecretRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := nomadSecretRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccNomadSecretRole_basic(t *testing.T) {
+	address, token := getTestNomadCreds(t)
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccNomadSecretRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadSecretRoleConfig_basic(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "role", name),
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "policies.0", "readonly"),
+				),
+			},
+			{
+				Config: testAccNomadSecretRoleConfig_updated(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "policies.1", "deploy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNomadSecretRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_nomad_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNomadSecretRoleConfig_basic(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  backend = "%s"
+  address = "%s"
+  token = "%s"
+}
+
+resource "vault_nomad_secret_role" "test" {
+  backend = "${vault_nomad_secret_backend.test.backend}"
+  role = "%s"
+  policies = ["readonly"]
+}
+`, backend, address, token, name)
+}
+
+func testAccNomadSecretRoleConfig_updated(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  backend = "%s"
+  address = "%s"
+  token = "%s"
+}
+
+resource "vault_nomad_secret_role" "test" {
+  backend = "${vault_nomad_secret_backend.test.backend}"
+  role = "%s"
+  policies = ["readonly", "deploy"]
+}
+`, backend, address, token, name)
+}
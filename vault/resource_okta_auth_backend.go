@@ -20,6 +20,9 @@ func oktaAuthBackendResource() *schema.Resource {
 		Delete: oktaAuthBackendDelete,
 		Read:   oktaAuthBackendRead,
 		Update: oktaAuthBackendUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -268,8 +271,21 @@ func oktaAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading okta oth mount from '%q': %s", path, err)
 	}
 
+	d.Set("path", path)
 	d.Set("accessor", mount.Accessor)
 
+	log.Printf("[DEBUG] Reading okta config for mount %s from Vault", path)
+	config, err := client.Logical().Read("auth/" + path + "/config")
+	if err != nil {
+		return fmt.Errorf("error reading okta config from '%q': %s", path, err)
+	}
+	if config != nil {
+		d.Set("organization", config.Data["organization"])
+		d.Set("base_url", config.Data["base_url"])
+		d.Set("bypass_okta_mfa", config.Data["bypass_okta_mfa"])
+		// token is not returned by the API and is kept from the configuration
+	}
+
 	log.Printf("[DEBUG] Reading groups for mount %s from Vault", path)
 	groups, err := oktaReadAllGroups(client, path)
 	if err != nil {
@@ -181,7 +181,7 @@ func oktaAuthBackendGroupID(path, groupName string) string {
 func oktaAuthBackendGroupPathFromID(id string) (string, error) {
 	var parts = strings.SplitN(id, "/", 2)
 	if len(parts) != 2 {
-		return "", fmt.Errorf("Expected 2 parts in ID '%s'", id)
+		return "", fmt.Errorf("expected 2 parts in ID '%s'", id)
 	}
 	return parts[0], nil
 }
@@ -189,7 +189,7 @@ func oktaAuthBackendGroupPathFromID(id string) (string, error) {
 func oktaAuthBackendGroupNameFromID(id string) (string, error) {
 	var parts = strings.SplitN(id, "/", 2)
 	if len(parts) != 2 {
-		return "", fmt.Errorf("Expected 2 parts in ID '%s'", id)
+		return "", fmt.Errorf("expected 2 parts in ID '%s'", id)
 	}
 	return parts[1], nil
 }
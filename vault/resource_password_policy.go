@@ -0,0 +1,109 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func passwordPolicyPath(name string) string {
+	return "sys/policies/password/" + name
+}
+
+func passwordPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: passwordPolicyWrite,
+		Update: passwordPolicyWrite,
+		Read:   passwordPolicyRead,
+		Delete: passwordPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the password policy.",
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The password policy document, in HCL or JSON.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if _, err := hcl.Parse(v.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("error parsing policy: %s", err))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func passwordPolicyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := passwordPolicyPath(name)
+
+	data := map[string]interface{}{
+		"policy": d.Get("policy").(string),
+	}
+
+	log.Printf("[DEBUG] Writing password policy %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing password policy %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote password policy %q", name)
+
+	d.SetId(name)
+
+	return passwordPolicyRead(d, meta)
+}
+
+func passwordPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := passwordPolicyPath(name)
+
+	log.Printf("[DEBUG] Reading password policy %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading password policy %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read password policy %q", name)
+
+	if resp == nil {
+		log.Printf("[WARN] Password policy %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("policy", resp.Data["policy"])
+
+	return nil
+}
+
+func passwordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := passwordPolicyPath(name)
+
+	log.Printf("[DEBUG] Deleting password policy %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting password policy %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted password policy %q", name)
+
+	return nil
+}
@@ -105,6 +105,12 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Default:     604800,
 				Description: "Generate a new certificate when the expiration is within this number of seconds",
 			},
+			"revoke": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Revoke the certificate upon resource destruction.",
+			},
 			"certificate": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -266,6 +272,25 @@ func pkiSecretBackendCertUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func pkiSecretBackendCertDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("revoke").(bool) {
+		return nil
+	}
+
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	serialNumber := d.Get("serial_number").(string)
+
+	path := strings.Trim(backend, "/") + "/revoke"
+	data := map[string]interface{}{
+		"serial_number": serialNumber,
+	}
+
+	log.Printf("[DEBUG] Revoking certificate %q on PKI secret backend %q", serialNumber, backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error revoking certificate %q on PKI secret backend %q: %s", serialNumber, backend, err)
+	}
+	log.Printf("[DEBUG] Revoked certificate %q on PKI secret backend %q", serialNumber, backend)
 	return nil
 }
 
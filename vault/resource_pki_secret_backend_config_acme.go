@@ -0,0 +1,168 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigAcmeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAcmeWrite,
+		Read:   pkiSecretBackendConfigAcmeRead,
+		Update: pkiSecretBackendConfigAcmeWrite,
+		Delete: pkiSecretBackendConfigAcmeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether ACME is enabled.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which issuers are allowed for use with ACME.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which roles are allowed for use with ACME.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allow_role_ext_key_usage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether the ExtKeyUsage field from a role is used.",
+			},
+			"default_directory_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the policy to be used for non-role-qualified ACME requests.",
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS resolver to use for domain resolution on this mount.",
+			},
+			"eab_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the policy to use for external account binding behaviour.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigAcmeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigAcmePath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOk("allowed_issuers"); ok {
+		data["allowed_issuers"] = v
+	}
+	if v, ok := d.GetOk("allowed_roles"); ok {
+		data["allowed_roles"] = v
+	}
+	if v, ok := d.GetOkExists("allow_role_ext_key_usage"); ok {
+		data["allow_role_ext_key_usage"] = v
+	}
+	if v, ok := d.GetOk("default_directory_policy"); ok {
+		data["default_directory_policy"] = v
+	}
+	if v, ok := d.GetOk("dns_resolver"); ok {
+		data["dns_resolver"] = v
+	}
+	if v, ok := d.GetOk("eab_policy"); ok {
+		data["eab_policy"] = v
+	}
+
+	log.Printf("[DEBUG] Writing ACME config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote ACME config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigAcmeRead(d, meta)
+}
+
+func pkiSecretBackendConfigAcmeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading ACME config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading ACME config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read ACME config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] ACME config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/acme"))
+	d.Set("enabled", resp.Data["enabled"])
+	d.Set("allowed_issuers", resp.Data["allowed_issuers"])
+	d.Set("allowed_roles", resp.Data["allowed_roles"])
+	d.Set("allow_role_ext_key_usage", resp.Data["allow_role_ext_key_usage"])
+	d.Set("default_directory_policy", resp.Data["default_directory_policy"])
+	d.Set("dns_resolver", resp.Data["dns_resolver"])
+	d.Set("eab_policy", resp.Data["eab_policy"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigAcmeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"enabled": false,
+	}
+
+	log.Printf("[DEBUG] Disabling ACME config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error disabling ACME config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled ACME config %q", path)
+	return nil
+}
+
+func pkiSecretBackendConfigAcmePath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/acme"
+}
@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigAutoTidyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAutoTidyWrite,
+		Read:   pkiSecretBackendConfigAutoTidyRead,
+		Update: pkiSecretBackendConfigAutoTidyWrite,
+		Delete: pkiSecretBackendConfigAutoTidyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether automatic tidy is enabled or not.",
+			},
+			"interval_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval at which to run an auto-tidy operation.",
+			},
+			"tidy_cert_store": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether to tidy up the certificate store.",
+			},
+			"tidy_revoked_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether to remove all invalid and expired certificates from storage.",
+			},
+			"tidy_expired_issuers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether tidy expired issuers.",
+			},
+			"safety_buffer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies a duration used as a safety buffer to ensure certificates are not expunged prematurely.",
+			},
+			"issuer_safety_buffer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of extra time that must have passed beyond issuer's expiration before it is removed from the backend storage.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigAutoTidyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOk("interval_duration"); ok {
+		data["interval_duration"] = v
+	}
+	if v, ok := d.GetOkExists("tidy_cert_store"); ok {
+		data["tidy_cert_store"] = v
+	}
+	if v, ok := d.GetOkExists("tidy_revoked_certs"); ok {
+		data["tidy_revoked_certs"] = v
+	}
+	if v, ok := d.GetOkExists("tidy_expired_issuers"); ok {
+		data["tidy_expired_issuers"] = v
+	}
+	if v, ok := d.GetOk("safety_buffer"); ok {
+		data["safety_buffer"] = v
+	}
+	if v, ok := d.GetOk("issuer_safety_buffer"); ok {
+		data["issuer_safety_buffer"] = v
+	}
+
+	log.Printf("[DEBUG] Writing auto-tidy config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing auto-tidy config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote auto-tidy config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigAutoTidyRead(d, meta)
+}
+
+func pkiSecretBackendConfigAutoTidyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading auto-tidy config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading auto-tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read auto-tidy config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Auto-tidy config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/auto-tidy"))
+	d.Set("enabled", resp.Data["enabled"])
+	d.Set("interval_duration", flattenVaultDuration(resp.Data["interval_duration"]))
+	d.Set("tidy_cert_store", resp.Data["tidy_cert_store"])
+	d.Set("tidy_revoked_certs", resp.Data["tidy_revoked_certs"])
+	if v, ok := resp.Data["tidy_expired_issuers"]; ok {
+		d.Set("tidy_expired_issuers", v)
+	}
+	d.Set("safety_buffer", flattenVaultDuration(resp.Data["safety_buffer"]))
+	if v, ok := resp.Data["issuer_safety_buffer"]; ok {
+		d.Set("issuer_safety_buffer", flattenVaultDuration(v))
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"enabled": false,
+	}
+
+	log.Printf("[DEBUG] Disabling auto-tidy config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error disabling auto-tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled auto-tidy config %q", path)
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/auto-tidy"
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigAutoTidy_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendConfigAutoTidyConfig_basic(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "tidy_cert_store", "true"),
+				),
+			},
+			{
+				Config: testAccPkiSecretBackendConfigAutoTidyConfig_basic(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_auto_tidy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendConfigAutoTidyConfig_basic(backend string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_config_auto_tidy" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  enabled = %t
+  tidy_cert_store = true
+  interval_duration = "12h"
+  safety_buffer = "3600"
+}
+`, backend, enabled)
+}
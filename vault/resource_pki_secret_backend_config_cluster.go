@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterWrite,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterWrite,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's API mount path.",
+			},
+			"aia_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's AIA distribution point.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	data := map[string]interface{}{
+		"path":     d.Get("path").(string),
+		"aia_path": d.Get("aia_path").(string),
+	}
+
+	log.Printf("[DEBUG] Writing cluster config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cluster config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading cluster config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read cluster config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Cluster config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/cluster"))
+	d.Set("path", resp.Data["path"])
+	d.Set("aia_path", resp.Data["aia_path"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"path":     "",
+		"aia_path": "",
+	}
+
+	log.Printf("[DEBUG] Clearing cluster config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error clearing cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Cleared cluster config %q", path)
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
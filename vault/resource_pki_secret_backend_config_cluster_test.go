@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigCluster_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendConfigClusterConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "path", fmt.Sprintf("http://127.0.0.1:8200/v1/%s", backend)),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendConfigClusterConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  path = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test.path}"
+  aia_path = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test.path}"
+}
+`, backend)
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigCmpResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigCmpWrite,
+		Read:   pkiSecretBackendConfigCmpRead,
+		Update: pkiSecretBackendConfigCmpWrite,
+		Delete: pkiSecretBackendConfigCmpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether CMPv2 is enabled.",
+			},
+			"default_path_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the behaviour of the default CMPv2 path.",
+			},
+			"authenticators": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies the mount accessors CMPv2 should delegate authentication requests towards.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor and cert_role properties for cert auth backends.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"userpass": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor property for userpass auth backends.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"enable_sentinel_parsing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, parse out fields from the provided CSR making them available for Sentinel policies.",
+			},
+			"audit_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fields parsed from the CSR that appear in the audit and can be used by sentinel policies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigCmpWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigCmpPath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOk("default_path_policy"); ok {
+		data["default_path_policy"] = v
+	}
+	if v, ok := d.GetOk("authenticators"); ok {
+		data["authenticators"] = expandPkiProtocolAuthenticators(v.([]interface{}))
+	}
+	if v, ok := d.GetOkExists("enable_sentinel_parsing"); ok {
+		data["enable_sentinel_parsing"] = v
+	}
+	if v, ok := d.GetOk("audit_fields"); ok {
+		data["audit_fields"] = v
+	}
+
+	log.Printf("[DEBUG] Writing CMPv2 config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing CMPv2 config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote CMPv2 config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigCmpRead(d, meta)
+}
+
+func pkiSecretBackendConfigCmpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading CMPv2 config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading CMPv2 config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read CMPv2 config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] CMPv2 config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/cmp"))
+	d.Set("enabled", resp.Data["enabled"])
+	d.Set("default_path_policy", resp.Data["default_path_policy"])
+	if v, ok := resp.Data["authenticators"]; ok {
+		d.Set("authenticators", flattenPkiProtocolAuthenticators(v))
+	}
+	d.Set("enable_sentinel_parsing", resp.Data["enable_sentinel_parsing"])
+	d.Set("audit_fields", resp.Data["audit_fields"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigCmpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"enabled": false,
+	}
+
+	log.Printf("[DEBUG] Disabling CMPv2 config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error disabling CMPv2 config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled CMPv2 config %q", path)
+	return nil
+}
+
+func pkiSecretBackendConfigCmpPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cmp"
+}
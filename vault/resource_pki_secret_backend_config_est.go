@@ -0,0 +1,218 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigEstResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigEstWrite,
+		Read:   pkiSecretBackendConfigEstRead,
+		Update: pkiSecretBackendConfigEstWrite,
+		Delete: pkiSecretBackendConfigEstDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether EST is enabled.",
+			},
+			"default_mount": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, this mount will register the default .well-known/est URL path.",
+			},
+			"default_path_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Required to be set if default_mount is enabled, specifies the behaviour of the default EST label.",
+			},
+			"label_to_path_policy": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of EST label to path policy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"authenticators": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies the mount accessors EST should delegate authentication requests towards.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor and cert_role properties for cert auth backends.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"userpass": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor property for userpass auth backends.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"enable_sentinel_parsing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, parse out fields from the provided CSR making them available for Sentinel policies.",
+			},
+			"audit_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fields parsed from the CSR that appear in the audit and can be used by sentinel policies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigEstWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigEstPath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOkExists("default_mount"); ok {
+		data["default_mount"] = v
+	}
+	if v, ok := d.GetOk("default_path_policy"); ok {
+		data["default_path_policy"] = v
+	}
+	if v, ok := d.GetOk("label_to_path_policy"); ok {
+		data["label_to_path_policy"] = v
+	}
+	if v, ok := d.GetOk("authenticators"); ok {
+		data["authenticators"] = expandPkiProtocolAuthenticators(v.([]interface{}))
+	}
+	if v, ok := d.GetOkExists("enable_sentinel_parsing"); ok {
+		data["enable_sentinel_parsing"] = v
+	}
+	if v, ok := d.GetOk("audit_fields"); ok {
+		data["audit_fields"] = v
+	}
+
+	log.Printf("[DEBUG] Writing EST config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing EST config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote EST config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigEstRead(d, meta)
+}
+
+func pkiSecretBackendConfigEstRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading EST config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading EST config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read EST config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] EST config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/est"))
+	d.Set("enabled", resp.Data["enabled"])
+	d.Set("default_mount", resp.Data["default_mount"])
+	d.Set("default_path_policy", resp.Data["default_path_policy"])
+	d.Set("label_to_path_policy", resp.Data["label_to_path_policy"])
+	if v, ok := resp.Data["authenticators"]; ok {
+		d.Set("authenticators", flattenPkiProtocolAuthenticators(v))
+	}
+	d.Set("enable_sentinel_parsing", resp.Data["enable_sentinel_parsing"])
+	d.Set("audit_fields", resp.Data["audit_fields"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigEstDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"enabled": false,
+	}
+
+	log.Printf("[DEBUG] Disabling EST config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error disabling EST config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled EST config %q", path)
+	return nil
+}
+
+func pkiSecretBackendConfigEstPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/est"
+}
+
+func expandPkiProtocolAuthenticators(raw []interface{}) map[string]interface{} {
+	authenticators := map[string]interface{}{}
+	if len(raw) == 0 || raw[0] == nil {
+		return authenticators
+	}
+	block := raw[0].(map[string]interface{})
+	if v, ok := block["cert"]; ok && len(v.(map[string]interface{})) > 0 {
+		authenticators["cert"] = v
+	}
+	if v, ok := block["userpass"]; ok && len(v.(map[string]interface{})) > 0 {
+		authenticators["userpass"] = v
+	}
+	return authenticators
+}
+
+func flattenPkiProtocolAuthenticators(raw interface{}) []interface{} {
+	authenticators, ok := raw.(map[string]interface{})
+	if !ok || len(authenticators) == 0 {
+		return nil
+	}
+	block := map[string]interface{}{}
+	if v, ok := authenticators["cert"]; ok {
+		block["cert"] = v
+	}
+	if v, ok := authenticators["userpass"]; ok {
+		block["userpass"] = v
+	}
+	return []interface{}{block}
+}
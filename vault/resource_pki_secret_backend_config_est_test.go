@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigEst_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendConfigEstConfig_basic(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "default_path_policy", "sign-verbatim"),
+				),
+			},
+			{
+				Config: testAccPkiSecretBackendConfigEstConfig_basic(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendConfigEstConfig_basic(backend string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  type = "internal"
+  common_name = "my.domain"
+  ttl = "86400"
+}
+
+resource "vault_pki_secret_backend_config_est" "test" {
+  depends_on = [ "vault_pki_secret_backend_root_cert.test" ]
+  backend = "${vault_pki_secret_backend.test.path}"
+  enabled = %t
+  default_mount = true
+  default_path_policy = "sign-verbatim"
+}
+`, backend, enabled)
+}
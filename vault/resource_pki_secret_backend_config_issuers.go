@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigIssuersResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigIssuersWrite,
+		Read:   pkiSecretBackendConfigIssuersRead,
+		Update: pkiSecretBackendConfigIssuersWrite,
+		Delete: pkiSecretBackendConfigIssuersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the default issuer by ID or name.",
+			},
+			"default_follows_latest_issuer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether a root creation or issuer import operation updates the default issuer to the newly added issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigIssuersWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigIssuersPath(backend)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("default"); ok {
+		data["default"] = v
+	}
+	if v, ok := d.GetOkExists("default_follows_latest_issuer"); ok {
+		data["default_follows_latest_issuer"] = v
+	}
+
+	log.Printf("[DEBUG] Writing issuers config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing issuers config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote issuers config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigIssuersRead(d, meta)
+}
+
+func pkiSecretBackendConfigIssuersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading issuers config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuers config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read issuers config from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Issuers config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/issuers"))
+	d.Set("default", resp.Data["default"])
+	if v, ok := resp.Data["default_follows_latest_issuer"]; ok {
+		d.Set("default_follows_latest_issuer", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersDelete(d *schema.ResourceData, meta interface{}) error {
+	// Issuer configuration cannot be unset; removing the resource only removes it from state.
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/issuers"
+}
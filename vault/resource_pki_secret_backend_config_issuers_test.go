@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigIssuers_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendConfigIssuersConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_issuers.test", "backend", backend),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_config_issuers.test", "default"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_issuers.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendConfigIssuersConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  type = "internal"
+  common_name = "my.domain"
+  ttl = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = [ "vault_pki_secret_backend_root_cert.test" ]
+  backend = "${vault_pki_secret_backend.test.path}"
+  issuer_ref = "default"
+}
+
+resource "vault_pki_secret_backend_config_issuers" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  default = "${vault_pki_secret_backend_issuer.test.issuer_id}"
+}
+`, backend)
+}
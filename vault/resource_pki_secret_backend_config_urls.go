@@ -15,6 +15,9 @@ func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 		Read:   pkiSecretBackendConfigUrlsRead,
 		Update: pkiSecretBackendConfigUrlsUpdate,
 		Delete: pkiSecretBackendConfigUrlsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -91,6 +94,7 @@ func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) er
 		return nil
 	}
 
+	d.Set("backend", strings.TrimSuffix(path, "/config/urls"))
 	d.Set("issuing_certificates", config.Data["issuing_certificates"])
 	d.Set("crl_distribution_points", config.Data["crl_distribution_points"])
 	d.Set("ocsp_servers", config.Data["ocsp_servers"])
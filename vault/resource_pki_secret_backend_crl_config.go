@@ -15,6 +15,9 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 		Read:   pkiSecretBackendCrlConfigRead,
 		Update: pkiSecretBackendCrlConfigUpdate,
 		Delete: pkiSecretBackendCrlConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -37,6 +40,26 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables periodic rebuilding of the CRL upon expiry.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Grace period before CRL expiry to attempt rebuild of CRL.",
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables building of delta CRLs with up-to-date revocation information, augmenting the last complete CRL.",
+			},
+			"delta_rebuild_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Interval to check for new revocations on, to regenerate the delta CRL.",
+			},
 		},
 	}
 }
@@ -54,6 +77,18 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	if disable, ok := d.GetOk("disable"); ok {
 		data["disable"] = disable
 	}
+	if v, ok := d.GetOk("auto_rebuild"); ok {
+		data["auto_rebuild"] = v
+	}
+	if v, ok := d.GetOk("auto_rebuild_grace_period"); ok {
+		data["auto_rebuild_grace_period"] = v
+	}
+	if v, ok := d.GetOk("enable_delta"); ok {
+		data["enable_delta"] = v
+	}
+	if v, ok := d.GetOk("delta_rebuild_interval"); ok {
+		data["delta_rebuild_interval"] = v
+	}
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -81,8 +116,21 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("invalid path ID %q: %s", path, err)
 	}
 
+	d.Set("backend", strings.TrimSuffix(path, "/config/crl"))
 	d.Set("expiry", config.Data["expiry"])
 	d.Set("disable", config.Data["disable"])
+	if v, ok := config.Data["auto_rebuild"]; ok {
+		d.Set("auto_rebuild", v)
+	}
+	if v, ok := config.Data["auto_rebuild_grace_period"]; ok {
+		d.Set("auto_rebuild_grace_period", v)
+	}
+	if v, ok := config.Data["enable_delta"]; ok {
+		d.Set("enable_delta", v)
+	}
+	if v, ok := config.Data["delta_rebuild_interval"]; ok {
+		d.Set("delta_rebuild_interval", v)
+	}
 
 	return nil
 }
@@ -100,6 +148,18 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	if disable, ok := d.GetOk("disable"); ok {
 		data["disable"] = disable
 	}
+	if v, ok := d.GetOk("auto_rebuild"); ok {
+		data["auto_rebuild"] = v
+	}
+	if v, ok := d.GetOk("auto_rebuild_grace_period"); ok {
+		data["auto_rebuild_grace_period"] = v
+	}
+	if v, ok := d.GetOk("enable_delta"); ok {
+		data["enable_delta"] = v
+	}
+	if v, ok := d.GetOk("delta_rebuild_interval"); ok {
+		data["delta_rebuild_interval"] = v
+	}
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -108,6 +108,13 @@ func pkiSecretBackendIntermediateCertRequestResource() *schema.Resource {
 				Description: "Flag to exclude CN from SANs.",
 				ForceNew:    true,
 			},
+			"add_basic_constraints": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set 'CA: true' in a Basic Constraints extension. Only needed as a workaround in some compatibility scenarios with Active Directory Certificate Services.",
+				ForceNew:    true,
+			},
 			"ou": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -203,19 +210,20 @@ func pkiSecretBackendIntermediateCertRequestCreate(d *schema.ResourceData, meta
 	}
 
 	data := map[string]interface{}{
-		"common_name":          d.Get("common_name").(string),
-		"format":               d.Get("format").(string),
-		"private_key_format":   d.Get("private_key_format").(string),
-		"key_type":             d.Get("key_type").(string),
-		"key_bits":             d.Get("key_bits").(int),
-		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
-		"ou":                   d.Get("ou").(string),
-		"organization":         d.Get("organization").(string),
-		"country":              d.Get("country").(string),
-		"locality":             d.Get("locality").(string),
-		"province":             d.Get("province").(string),
-		"street_address":       d.Get("street_address").(string),
-		"postal_code":          d.Get("postal_code").(string),
+		"common_name":           d.Get("common_name").(string),
+		"format":                d.Get("format").(string),
+		"private_key_format":    d.Get("private_key_format").(string),
+		"key_type":              d.Get("key_type").(string),
+		"key_bits":              d.Get("key_bits").(int),
+		"exclude_cn_from_sans":  d.Get("exclude_cn_from_sans").(bool),
+		"add_basic_constraints": d.Get("add_basic_constraints").(bool),
+		"ou":                    d.Get("ou").(string),
+		"organization":          d.Get("organization").(string),
+		"country":               d.Get("country").(string),
+		"locality":              d.Get("locality").(string),
+		"province":              d.Get("province").(string),
+		"street_address":        d.Get("street_address").(string),
+		"postal_code":           d.Get("postal_code").(string),
 	}
 
 	if len(altNames) > 0 {
@@ -28,6 +28,22 @@ func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
 				Description: "The certificate.",
 				ForceNew:    true,
 			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The imported issuers, only set on multi-issuer capable mounts.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The imported keys, only set on multi-issuer capable mounts.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -44,12 +60,17 @@ func pkiSecretBackendIntermediateSetSignedCreate(d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Creating intermediate set-signed on PKI secret backend %q", backend)
-	_, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating intermediate set-signed on PKI secret backend %q: %s", backend, err)
 	}
 	log.Printf("[DEBUG] Created intermediate set-signed on PKI secret backend %q", backend)
 
+	if resp != nil {
+		d.Set("imported_issuers", resp.Data["imported_issuers"])
+		d.Set("imported_keys", resp.Data["imported_keys"])
+	}
+
 	d.SetId(path)
 	return pkiSecretBackendIntermediateSetSignedRead(d, meta)
 }
@@ -0,0 +1,241 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuerCreate,
+		Read:   pkiSecretBackendIssuerRead,
+		Update: pkiSecretBackendIssuerUpdate,
+		Delete: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to an existing issuer, by ID or name.",
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the issuer.",
+			},
+			"leaf_not_after_behavior": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Behavior of leaf's NotAfter fields.",
+			},
+			"manual_chain": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Chain of issuer references to build this issuer's computed CAChain field from, when non-empty.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"usage": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comma-separated list of allowed usages for this issuer.",
+			},
+			"revocation_signature_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Which signature algorithm to use when building CRLs.",
+			},
+			"issuing_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the URL values for the Issuing Certificate field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"crl_distribution_points": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the URL values for the CRL Distribution Points field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ocsp_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the URL values for the OCSP Servers field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issuer.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate associated with this issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerWriteFields() []string {
+	return []string{
+		"issuer_name",
+		"leaf_not_after_behavior",
+		"manual_chain",
+		"usage",
+		"revocation_signature_algorithm",
+		"issuing_certificates",
+		"crl_distribution_points",
+		"ocsp_servers",
+	}
+}
+
+func pkiSecretBackendIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	issuerRef := d.Get("issuer_ref").(string)
+
+	path := pkiSecretBackendIssuerPath(backend, issuerRef)
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendIssuerWriteFields() {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	if len(data) > 0 {
+		log.Printf("[DEBUG] Updating issuer %q on PKI secret backend %q", issuerRef, backend)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating issuer %q on PKI secret backend %q: %s", issuerRef, backend, err)
+		}
+		log.Printf("[DEBUG] Updated issuer %q on PKI secret backend %q", issuerRef, backend)
+	}
+
+	// resolve the reference to the issuer ID so renames don't invalidate the ID
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q on PKI secret backend %q: %s", issuerRef, backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("issuer %q not found on PKI secret backend %q", issuerRef, backend)
+	}
+
+	d.SetId(pkiSecretBackendIssuerPath(backend, resp.Data["issuer_id"].(string)))
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading issuer from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read issuer from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Issuer %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", pkiSecretBackendIssuerBackendFromPath(path))
+	if _, ok := d.GetOk("issuer_ref"); !ok {
+		d.Set("issuer_ref", resp.Data["issuer_id"])
+	}
+	d.Set("issuer_id", resp.Data["issuer_id"])
+	d.Set("issuer_name", resp.Data["issuer_name"])
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("leaf_not_after_behavior", resp.Data["leaf_not_after_behavior"])
+	d.Set("manual_chain", resp.Data["manual_chain"])
+	d.Set("usage", resp.Data["usage"])
+	d.Set("revocation_signature_algorithm", resp.Data["revocation_signature_algorithm"])
+	d.Set("issuing_certificates", resp.Data["issuing_certificates"])
+	d.Set("crl_distribution_points", resp.Data["crl_distribution_points"])
+	d.Set("ocsp_servers", resp.Data["ocsp_servers"])
+
+	return nil
+}
+
+func pkiSecretBackendIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendIssuerWriteFields() {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
+	if len(data) > 0 {
+		log.Printf("[DEBUG] Updating issuer %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating issuer %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated issuer %q", path)
+	}
+
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting issuer %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted issuer %q", path)
+	return nil
+}
+
+func pkiSecretBackendIssuerPath(backend, issuerRef string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + issuerRef
+}
+
+func pkiSecretBackendIssuerBackendFromPath(path string) string {
+	if i := strings.LastIndex(path, "/issuer/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccPkiSecretBackendIssuer_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	issuerName := acctest.RandomWithPrefix("tf-test-issuer")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendIssuerConfig_basic(backend, issuerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuer_name", issuerName),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_issuer.test", "issuer_id"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_issuer.test", "certificate"),
+				),
+			},
+			{
+				Config: testAccPkiSecretBackendIssuerConfig_basic(backend, issuerName+"-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuer_name", issuerName+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendIssuerConfig_basic(backend, issuerName string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  type = "internal"
+  common_name = "my.domain"
+  ttl = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = [ "vault_pki_secret_backend_root_cert.test" ]
+  backend = "${vault_pki_secret_backend.test.path}"
+  issuer_ref = "default"
+  issuer_name = "%s"
+}
+`, backend, issuerName)
+}
@@ -0,0 +1,175 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendKeyCreate,
+		Read:   pkiSecretBackendKeyRead,
+		Update: pkiSecretBackendKeyUpdate,
+		Delete: pkiSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of key to create. Must be either \"exported\" or \"internal\".",
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When a new key is created with this request, optionally specifies the name for this.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa",
+				Description:  "Specifies the desired key type; must be \"rsa\", \"ed25519\" or \"ec\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ed25519", "ec"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     2048,
+				Description: "Specifies the number of bits to use for the generated keys.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the generated key.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key, only set when type is \"exported\".",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	keyType := d.Get("type").(string)
+
+	path := strings.Trim(backend, "/") + "/keys/generate/" + keyType
+
+	data := map[string]interface{}{
+		"key_type": d.Get("key_type").(string),
+		"key_bits": d.Get("key_bits").(int),
+	}
+	if v, ok := d.GetOk("key_name"); ok {
+		data["key_name"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Generating key on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating key on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Generated key on PKI secret backend %q", backend)
+
+	keyID := resp.Data["key_id"].(string)
+	d.Set("private_key", resp.Data["private_key"])
+
+	d.SetId(pkiSecretBackendKeyPath(backend, keyID))
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", pkiSecretBackendKeyBackendFromPath(path))
+	d.Set("key_id", resp.Data["key_id"])
+	d.Set("key_name", resp.Data["key_name"])
+	d.Set("key_type", resp.Data["key_type"])
+	if v, ok := resp.Data["key_bits"]; ok {
+		d.Set("key_bits", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("key_name") {
+		data := map[string]interface{}{
+			"key_name": d.Get("key_name").(string),
+		}
+		log.Printf("[DEBUG] Updating key %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated key %q", path)
+	}
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key %q", path)
+	return nil
+}
+
+func pkiSecretBackendKeyPath(backend, keyID string) string {
+	return strings.Trim(backend, "/") + "/key/" + keyID
+}
+
+func pkiSecretBackendKeyBackendFromPath(path string) string {
+	if i := strings.LastIndex(path, "/key/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
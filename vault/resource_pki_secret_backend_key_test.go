@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccPkiSecretBackendKey_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	keyName := acctest.RandomWithPrefix("tf-test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPkiSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPkiSecretBackendKeyConfig_basic(backend, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "type", "internal"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_type", "rsa"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_bits", "2048"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_key.test", "key_id"),
+				),
+			},
+			{
+				Config: testAccPkiSecretBackendKeyConfig_basic(backend, keyName+"-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_name", keyName+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendKeyCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccPkiSecretBackendKeyConfig_basic(backend, keyName string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds = "86400"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  type = "internal"
+  key_name = "%s"
+  key_type = "rsa"
+  key_bits = 2048
+}
+`, backend, keyName)
+}
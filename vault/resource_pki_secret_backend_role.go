@@ -76,6 +76,13 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"allowed_domains_template": {
+				Type:        schema.TypeBool,
+				Required:    false,
+				Optional:    true,
+				Description: "Flag, if set, indicates that the entries in allowed_domains may contain templates, as with ACL path templating.",
+				Default:     false,
+			},
 			"allow_bare_domains": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -305,6 +312,22 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description:  "Specifies the duration by which to backdate the NotBefore property.",
 				ValidateFunc: validateDuration,
 			},
+			"cn_validations": {
+				Type:        schema.TypeList,
+				Required:    false,
+				Optional:    true,
+				Description: "Validations to run on the Common Name field of the certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the default issuer of this request, by name, ID, or \"default\", for multi-issuer mounts.",
+			},
 		},
 	}
 }
@@ -375,6 +398,11 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"allowed_domains_template":           d.Get("allowed_domains_template"),
+	}
+
+	if v, ok := d.GetOk("issuer_ref"); ok {
+		data["issuer_ref"] = v
 	}
 
 	if len(allowedDomains) > 0 {
@@ -393,6 +421,10 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
+	if v, ok := d.GetOk("cn_validations"); ok {
+		data["cn_validations"] = v
+	}
+
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -502,6 +534,15 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("policy_identifiers", policyIdentifiers)
 	d.Set("basic_constraints_valid_for_non_ca", secret.Data["basic_constraints_valid_for_non_ca"])
 	d.Set("not_before_duration", notBeforeDuration)
+	if v, ok := secret.Data["allowed_domains_template"]; ok {
+		d.Set("allowed_domains_template", v)
+	}
+	if v, ok := secret.Data["cn_validations"]; ok {
+		d.Set("cn_validations", v)
+	}
+	if v, ok := secret.Data["issuer_ref"]; ok {
+		d.Set("issuer_ref", v)
+	}
 
 	return nil
 }
@@ -568,6 +609,11 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"allowed_domains_template":           d.Get("allowed_domains_template"),
+	}
+
+	if v, ok := d.GetOk("issuer_ref"); ok {
+		data["issuer_ref"] = v
 	}
 
 	if len(allowedDomains) > 0 {
@@ -586,6 +632,10 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
+	if v, ok := d.GetOk("cn_validations"); ok {
+		data["cn_validations"] = v
+	}
+
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
@@ -79,6 +79,12 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				ForceNew:    false,
 				Description: "Time to leave.",
 			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Set the Not After field of the certificate with specified date value, in RFC3339 format. Supports the Y10K value 9999-12-31T23:59:59Z to indicate a missing expiration.",
+			},
 			"format": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -174,6 +180,18 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Description: "The postal code.",
 				ForceNew:    true,
 			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Provides a name to the generated issuer, for use with multi-issuer mounts.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When set, destroying this resource returns an error instead of deleting the root CA from the mount.",
+			},
 			"certificate": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -189,6 +207,27 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Computed:    true,
 				Description: "The serial number.",
 			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key, only set when type is \"exported\".",
+			},
+			"private_key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key type, only set when type is \"exported\".",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated issuer, only set on multi-issuer capable mounts.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated key, only set on multi-issuer capable mounts.",
+			},
 		},
 	}
 }
@@ -269,6 +308,14 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 		data["permitted_dns_domains"] = strings.Join(permittedDNSDomains, ",")
 	}
 
+	if v, ok := d.GetOk("not_after"); ok {
+		data["not_after"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("issuer_name"); ok {
+		data["issuer_name"] = v.(string)
+	}
+
 	log.Printf("[DEBUG] Creating root cert on PKI secret backend %q", backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -279,6 +326,10 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("issuing_ca", resp.Data["issuing_ca"])
 	d.Set("serial", resp.Data["serial"])
+	d.Set("private_key", resp.Data["private_key"])
+	d.Set("private_key_type", resp.Data["private_key_type"])
+	d.Set("issuer_id", resp.Data["issuer_id"])
+	d.Set("key_id", resp.Data["key_id"])
 
 	d.SetId(path)
 	return pkiSecretBackendRootCertRead(d, meta)
@@ -297,6 +348,10 @@ func pkiSecretBackendRootCertDelete(d *schema.ResourceData, meta interface{}) er
 
 	backend := d.Get("backend").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("cannot destroy root cert for PKI secret backend %q: deletion_protection is enabled", backend)
+	}
+
 	path := pkiSecretBackendIntermediateSetSignedDeletePath(backend)
 
 	log.Printf("[DEBUG] Deleting root cert from PKI secret backend %q", path)
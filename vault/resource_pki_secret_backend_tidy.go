@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendTidyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendTidyCreate,
+		Read:   pkiSecretBackendTidyRead,
+		Delete: pkiSecretBackendTidyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"tidy_cert_store": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies whether to tidy up the certificate store.",
+			},
+			"tidy_revoked_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies whether to remove all invalid and expired certificates from storage.",
+			},
+			"safety_buffer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies a duration used as a safety buffer to ensure certificates are not expunged prematurely.",
+			},
+			"tidy_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value that, when changed, triggers another tidy operation.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendTidyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendTidyPath(backend)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOkExists("tidy_cert_store"); ok {
+		data["tidy_cert_store"] = v
+	}
+	if v, ok := d.GetOkExists("tidy_revoked_certs"); ok {
+		data["tidy_revoked_certs"] = v
+	}
+	if v, ok := d.GetOk("safety_buffer"); ok {
+		data["safety_buffer"] = v
+	}
+
+	log.Printf("[DEBUG] Running tidy on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error running tidy on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Ran tidy on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return nil
+}
+
+func pkiSecretBackendTidyRead(d *schema.ResourceData, meta interface{}) error {
+	// The tidy endpoint is write-only; there is nothing to read back.
+	return nil
+}
+
+func pkiSecretBackendTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	// A tidy operation cannot be undone; removing the resource only removes it from state.
+	return nil
+}
+
+func pkiSecretBackendTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/tidy"
+}
@@ -0,0 +1,163 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pluginTypes = []string{"auth", "secret", "database"}
+
+func pluginCatalogPath(pluginType, name string) string {
+	return "sys/plugins/catalog/" + pluginType + "/" + name
+}
+
+func pluginResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginWrite,
+		Update: pluginWrite,
+		Read:   pluginRead,
+		Delete: pluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of plugin; one of \"auth\", \"secret\", or \"database\".",
+				ValidateFunc: validation.StringInSlice(pluginTypes, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SHA256 sum of the plugin binary.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Command to execute the plugin, relative to the server's configured plugin_directory.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Semantic version of the plugin.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of additional arguments to pass to the plugin.",
+			},
+			"env": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of additional environment variables to run the plugin with in KEY=VALUE form.",
+			},
+		},
+	}
+}
+
+func pluginWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginCatalogPath(pluginType, name)
+
+	data := map[string]interface{}{
+		"sha256":  d.Get("sha256").(string),
+		"command": d.Get("command").(string),
+	}
+	if v, ok := d.GetOk("version"); ok {
+		data["version"] = v.(string)
+	}
+	if v, ok := d.GetOk("args"); ok {
+		data["args"] = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("env"); ok {
+		data["env"] = expandStringSlice(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Writing plugin %q to catalog", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing plugin %q to catalog: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote plugin %q to catalog", path)
+
+	d.SetId(pluginType + "/" + name)
+
+	return pluginRead(d, meta)
+}
+
+func pluginRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected ID in format type/name, received %s", d.Id())
+	}
+	pluginType := parts[0]
+	name := parts[1]
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading plugin %q from catalog", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading plugin %q from catalog: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read plugin %q from catalog", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin %q not found in catalog, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", pluginType)
+	d.Set("name", name)
+	d.Set("sha256", resp.Data["sha256"])
+	d.Set("command", resp.Data["command"])
+	if v, ok := resp.Data["version"]; ok {
+		d.Set("version", v)
+	}
+	if v, ok := resp.Data["args"]; ok {
+		d.Set("args", v)
+	}
+	// env is not returned by the API, as it may contain sensitive values.
+
+	return nil
+}
+
+func pluginDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Removing plugin %q from catalog", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error removing plugin %q from catalog: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed plugin %q from catalog", path)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginPinnedVersionPath(pluginType, name string) string {
+	return "sys/plugins/pins/" + pluginType + "/" + name
+}
+
+func pluginPinnedVersionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginPinnedVersionWrite,
+		Update: pluginPinnedVersionWrite,
+		Read:   pluginPinnedVersionRead,
+		Delete: pluginPinnedVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of plugin; one of \"auth\", \"secret\", or \"database\".",
+				ValidateFunc: validation.StringInSlice(pluginTypes, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Semantic version of the plugin to pin.",
+			},
+		},
+	}
+}
+
+func pluginPinnedVersionWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	data := map[string]interface{}{
+		"version": d.Get("version").(string),
+	}
+
+	log.Printf("[DEBUG] Writing pinned version for plugin %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing pinned version for plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote pinned version for plugin %q", path)
+
+	d.SetId(pluginType + "/" + name)
+
+	return pluginPinnedVersionRead(d, meta)
+}
+
+func pluginPinnedVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected ID in format type/name, received %s", d.Id())
+	}
+	pluginType := parts[0]
+	name := parts[1]
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading pinned version for plugin %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading pinned version for plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read pinned version for plugin %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Pinned version for plugin %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", pluginType)
+	d.Set("name", name)
+	d.Set("version", resp.Data["version"])
+
+	return nil
+}
+
+func pluginPinnedVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Removing pinned version for plugin %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error removing pinned version for plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed pinned version for plugin %q", path)
+
+	return nil
+}
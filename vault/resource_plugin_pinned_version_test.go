@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccPluginPinnedVersion_basic(t *testing.T) {
+	command, sha256 := getTestPluginCommand(t)
+	name := acctest.RandomWithPrefix("tf-test-plugin")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPluginPinnedVersionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginPinnedVersionConfig_basic(name, command, sha256),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_plugin_pinned_version.test", "type", "secret"),
+					resource.TestCheckResourceAttr("vault_plugin_pinned_version.test", "name", name),
+					resource.TestCheckResourceAttr("vault_plugin_pinned_version.test", "version", "v1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPluginPinnedVersionCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_pinned_version" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/plugins/pins/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("pinned version %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccPluginPinnedVersionConfig_basic(name, command, sha256 string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type = "secret"
+  name = "%s"
+  command = "%s"
+  sha256 = "%s"
+  version = "v1.0.0"
+}
+
+resource "vault_plugin_pinned_version" "test" {
+  type = "${vault_plugin.test.type}"
+  name = "${vault_plugin.test.name}"
+  version = "${vault_plugin.test.version}"
+}
+`, name, command, sha256)
+}
@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginRuntimePath(runtimeType, name string) string {
+	return "sys/plugins/runtimes/catalog/" + runtimeType + "/" + name
+}
+
+func pluginRuntimeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginRuntimeWrite,
+		Update: pluginRuntimeWrite,
+		Read:   pluginRuntimeRead,
+		Delete: pluginRuntimeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of plugin runtime; currently only \"container\" is supported.",
+				ValidateFunc: validation.StringInSlice([]string{"container"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin runtime.",
+			},
+			"oci_runtime": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OCI-compatible runtime to use, such as runsc.",
+			},
+			"cgroup_parent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Parent cgroup to set for each container.",
+			},
+			"cpu_nanos": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "CPU limit to set per container in nanos.",
+			},
+			"memory_bytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Memory limit to set per container in bytes.",
+			},
+			"rootless": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the container runtime is configured to run as a non-privileged (non-root) user.",
+			},
+		},
+	}
+}
+
+func pluginRuntimeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	runtimeType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginRuntimePath(runtimeType, name)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("oci_runtime"); ok {
+		data["oci_runtime"] = v.(string)
+	}
+	if v, ok := d.GetOk("cgroup_parent"); ok {
+		data["cgroup_parent"] = v.(string)
+	}
+	if v, ok := d.GetOk("cpu_nanos"); ok {
+		data["cpu_nanos"] = v.(int)
+	}
+	if v, ok := d.GetOk("memory_bytes"); ok {
+		data["memory_bytes"] = v.(int)
+	}
+	if v, ok := d.GetOkExists("rootless"); ok {
+		data["rootless"] = v.(bool)
+	}
+
+	log.Printf("[DEBUG] Writing plugin runtime %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing plugin runtime %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote plugin runtime %q", path)
+
+	d.SetId(runtimeType + "/" + name)
+
+	return pluginRuntimeRead(d, meta)
+}
+
+func pluginRuntimeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected ID in format type/name, received %s", d.Id())
+	}
+	runtimeType := parts[0]
+	name := parts[1]
+	path := pluginRuntimePath(runtimeType, name)
+
+	log.Printf("[DEBUG] Reading plugin runtime %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading plugin runtime %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read plugin runtime %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin runtime %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", runtimeType)
+	d.Set("name", name)
+	for _, k := range []string{"oci_runtime", "cgroup_parent", "cpu_nanos", "memory_bytes", "rootless"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func pluginRuntimeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	runtimeType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginRuntimePath(runtimeType, name)
+
+	log.Printf("[DEBUG] Removing plugin runtime %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error removing plugin runtime %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed plugin runtime %q", path)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccPluginRuntime_basic(t *testing.T) {
+	if os.Getenv("VAULT_PLUGIN_RUNTIME") == "" {
+		t.Skip("VAULT_PLUGIN_RUNTIME not set")
+	}
+	name := acctest.RandomWithPrefix("tf-test-runtime")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPluginRuntimeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginRuntimeConfig_basic(name, 1000000000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_plugin_runtime.test", "type", "container"),
+					resource.TestCheckResourceAttr("vault_plugin_runtime.test", "name", name),
+					resource.TestCheckResourceAttr("vault_plugin_runtime.test", "cpu_nanos", "1000000000"),
+				),
+			},
+			{
+				Config: testAccPluginRuntimeConfig_basic(name, 2000000000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_plugin_runtime.test", "cpu_nanos", "2000000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPluginRuntimeCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_runtime" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/plugins/runtimes/catalog/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("plugin runtime %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccPluginRuntimeConfig_basic(name string, cpuNanos int) string {
+	return fmt.Sprintf(`
+resource "vault_plugin_runtime" "test" {
+  type = "container"
+  name = "%s"
+  oci_runtime = "runsc"
+  cpu_nanos = %d
+}
+`, name, cpuNanos)
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestPluginCommand(t *testing.T) (string, string) {
+	command := os.Getenv("VAULT_PLUGIN_COMMAND")
+	sha256 := os.Getenv("VAULT_PLUGIN_SHA256")
+	if command == "" {
+		t.Skip("VAULT_PLUGIN_COMMAND not set")
+	}
+	if sha256 == "" {
+		t.Skip("VAULT_PLUGIN_SHA256 not set")
+	}
+	return command, sha256
+}
+
+func TestAccPlugin_basic(t *testing.T) {
+	command, sha256 := getTestPluginCommand(t)
+	name := acctest.RandomWithPrefix("tf-test-plugin")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPluginCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_basic(name, command, sha256),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_plugin.test", "type", "secret"),
+					resource.TestCheckResourceAttr("vault_plugin.test", "name", name),
+					resource.TestCheckResourceAttr("vault_plugin.test", "command", command),
+					resource.TestCheckResourceAttr("vault_plugin.test", "sha256", sha256),
+				),
+			},
+		},
+	})
+}
+
+func testAccPluginCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/plugins/catalog/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("plugin %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccPluginConfig_basic(name, command, sha256 string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type = "secret"
+  name = "%s"
+  command = "%s"
+  sha256 = "%s"
+}
+`, name, command, sha256)
+}
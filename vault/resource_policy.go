@@ -79,6 +79,12 @@ func policyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
+	if policy == "" {
+		log.Printf("[WARN] policy %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("policy", policy)
 	d.Set("name", name)
 
@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func quotaLeaseCountPath(name string) string {
+	return "sys/quotas/lease-count/" + name
+}
+
+func quotaLeaseCountResource() *schema.Resource {
+	return &schema.Resource{
+		Create: quotaLeaseCountCreate,
+		Read:   quotaLeaseCountRead,
+		Update: quotaLeaseCountUpdate,
+		Delete: quotaLeaseCountDelete,
+		Exists: quotaLeaseCountExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the quota.",
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of the mount or namespace to apply the quota. A blank path configures a global lease count quota. " +
+					"For example namespace1/ adds a quota to a full namespace, namespace1/auth/userpass adds a quota to userpass in namespace1. " +
+					"Updating this field on an existing quota can have \"moving\" effects. For example, updating auth/userpass to " +
+					"namespace1/auth/userpass moves this quota from being a global mount quota to a namespace specific mount quota.",
+			},
+			"max_leases": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The maximum number of leases to be allowed by the quota rule. The max_leases must be positive.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(int)
+					if value <= 0 {
+						errs = append(errs, fmt.Errorf("max_leases must be greater than 0, got %d", value))
+					}
+					return
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace.",
+			},
+		},
+	}
+}
+
+func quotaLeaseCountData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["max_leases"] = d.Get("max_leases").(int)
+	if v, ok := d.GetOk("role"); ok {
+		data["role"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("inheritable"); ok {
+		data["inheritable"] = v.(bool)
+	}
+	return data
+}
+
+func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := quotaLeaseCountPath(name)
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Creating Resource Lease Count Quota %s", name)
+
+	_, err := client.Logical().Write(path, quotaLeaseCountData(d))
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("error creating Resource Lease Count Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Created Resource Lease Count Quota %s", name)
+
+	return quotaLeaseCountRead(d, meta)
+}
+
+func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := quotaLeaseCountPath(name)
+
+	log.Printf("[DEBUG] Reading Resource Lease Count Quota %s", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Resource Lease Count Quota %s: %s", name, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Resource Lease Count Quota %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"path", "max_leases", "role", "inheritable"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := quotaLeaseCountPath(name)
+
+	log.Printf("[DEBUG] Updating Resource Lease Count Quota %s", name)
+
+	_, err := client.Logical().Write(path, quotaLeaseCountData(d))
+	if err != nil {
+		return fmt.Errorf("error updating Resource Lease Count Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated Resource Lease Count Quota %s", name)
+
+	return quotaLeaseCountRead(d, meta)
+}
+
+func quotaLeaseCountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := quotaLeaseCountPath(name)
+
+	log.Printf("[DEBUG] Deleting Resource Lease Count Quota %s", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Resource Lease Count Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted Resource Lease Count Quota %s", name)
+
+	return nil
+}
+
+func quotaLeaseCountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := quotaLeaseCountPath(name)
+
+	log.Printf("[DEBUG] Checking if Resource Lease Count Quota %s exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Resource Lease Count Quota %s exists: %s", name, err)
+	}
+	log.Printf("[DEBUG] Checked if Resource Lease Count Quota %s exists", name)
+
+	return resp != nil, nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccQuotaLeaseCount_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	name := acctest.RandomWithPrefix("tf-test-quota")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccQuotaLeaseCountCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotaLeaseCountConfig_basic(name, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_lease_count.test", "name", name),
+					resource.TestCheckResourceAttr("vault_quota_lease_count.test", "max_leases", "100"),
+				),
+			},
+			{
+				Config: testAccQuotaLeaseCountConfig_basic(name, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_lease_count.test", "max_leases", "200"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_lease_count.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccQuotaLeaseCountCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_quota_lease_count" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/quotas/lease-count/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("lease count quota %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccQuotaLeaseCountConfig_basic(name, maxLeases string) string {
+	return fmt.Sprintf(`
+resource "vault_quota_lease_count" "test" {
+  name = "%s"
+  max_leases = %s
+}
+`, name, maxLeases)
+}
@@ -69,6 +69,44 @@ func rabbitmqSecretBackendRoleResource() *schema.Resource {
 					},
 				},
 			},
+			"vhost_topic": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies a map of virtual hosts to topic permissions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The vhost to set permissions for.",
+						},
+						"vhost": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Specifies a map of topics to permissions.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The vhost topic to set permissions for.",
+									},
+									"read": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The read permissions for this vhost topic.",
+									},
+									"write": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The write permissions for this vhost topic.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -107,9 +145,44 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] vhosts as JSON: %+v", vhostsJSON)
 
+	vhostTopic := d.Get("vhost_topic").([]interface{})
+	vhostTopics := make(map[string]interface{}, len(vhostTopic))
+
+	for _, host := range vhostTopic {
+		topics := map[string]interface{}{}
+		var id string
+		for k, v := range host.(map[string]interface{}) {
+			if k == "host" {
+				id = v.(string)
+				continue
+			}
+			for _, topic := range v.([]interface{}) {
+				t := map[string]interface{}{}
+				var topicID string
+				for tk, tv := range topic.(map[string]interface{}) {
+					if tk == "topic" {
+						topicID = tv.(string)
+						continue
+					}
+					t[tk] = tv
+				}
+				topics[topicID] = t
+			}
+		}
+		vhostTopics[id] = topics
+	}
+
+	log.Printf("[DEBUG] vhost topics after munging: %+v", vhostTopics)
+
+	vhostTopicsJSON, err := json.Marshal(vhostTopics)
+	if err != nil {
+		return fmt.Errorf("error serializing vhost topics: %s", err)
+	}
+
 	data := map[string]interface{}{
-		"tags":   tags,
-		"vhosts": string(vhostsJSON),
+		"tags":         tags,
+		"vhosts":       string(vhostsJSON),
+		"vhost_topics": string(vhostTopicsJSON),
 	}
 	log.Printf("[DEBUG] Creating role %q on Rabbitmq backend %q", name, backend)
 	_, err = client.Logical().Write(backend+"/roles/"+name, data)
@@ -122,6 +195,7 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", name)
 	d.Set("tags", tags)
 	d.Set("vhost", vhost)
+	d.Set("vhost_topic", vhostTopic)
 	d.Set("backend", backend)
 	return rabbitmqSecretBackendRoleRead(d, meta)
 }
@@ -159,10 +233,32 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 			})
 		}
 	}
+	var vhostTopics []map[string]interface{}
+	if v, ok := secret.Data["vhost_topics"]; ok && v != nil {
+		hosts := v.(map[string]interface{})
+		for id, val := range hosts {
+			var topics []map[string]interface{}
+			for topicID, topicVal := range val.(map[string]interface{}) {
+				vals := topicVal.(map[string]interface{})
+				topics = append(topics, map[string]interface{}{
+					"topic": topicID,
+					"write": vals["write"],
+					"read":  vals["read"],
+				})
+			}
+			vhostTopics = append(vhostTopics, map[string]interface{}{
+				"host":  id,
+				"vhost": topics,
+			})
+		}
+	}
 	d.Set("tags", secret.Data["tags"])
 	if err := d.Set("vhost", vhosts); err != nil {
 		return fmt.Errorf("Error setting vhosts in state: %s", err)
 	}
+	if err := d.Set("vhost_topic", vhostTopics); err != nil {
+		return fmt.Errorf("Error setting vhost topics in state: %s", err)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
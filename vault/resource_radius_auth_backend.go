@@ -0,0 +1,256 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/terraform-providers/terraform-provider-vault/util"
+)
+
+const radiusAuthType string = "radius"
+
+func radiusAuthBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"host": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"secret": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"unregistered_user_policies": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+		"dial_timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"read_timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"nas_port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"nas_identifier": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "radius",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+
+		"accessor": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The accessor of the RADIUS auth backend",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		SchemaVersion: 1,
+
+		Create: radiusAuthBackendWrite,
+		Update: radiusAuthBackendUpdate,
+		Read:   radiusAuthBackendRead,
+		Delete: radiusAuthBackendDelete,
+		Exists: radiusAuthBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func radiusAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
+
+func radiusAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	authType := radiusAuthType
+	path := d.Get("path").(string)
+	desc := d.Get("description").(string)
+
+	log.Printf("[DEBUG] Enabling RADIUS auth backend %q", path)
+	err := client.Sys().EnableAuth(path, authType, desc)
+	if err != nil {
+		return fmt.Errorf("error enabling radius auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled RADIUS auth backend %q", path)
+
+	d.SetId(path)
+
+	return radiusAuthBackendUpdate(d, meta)
+}
+
+func radiusAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := radiusAuthBackendConfigPath(d.Id())
+	data := map[string]interface{}{}
+
+	data["host"] = d.Get("host").(string)
+	data["secret"] = d.Get("secret").(string)
+
+	if v, ok := d.GetOk("port"); ok {
+		data["port"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("unregistered_user_policies"); ok {
+		policies := v.(*schema.Set).List()
+		data["unregistered_user_policies"] = strings.Join(util.ToStringArray(policies), ",")
+	}
+
+	if v, ok := d.GetOk("dial_timeout"); ok {
+		data["dial_timeout"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("read_timeout"); ok {
+		data["read_timeout"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("nas_port"); ok {
+		data["nas_port"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("nas_identifier"); ok {
+		data["nas_identifier"] = v.(string)
+	}
+
+	updateTokenFields(d, data, false)
+
+	log.Printf("[DEBUG] Writing RADIUS config %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("error writing radius config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote RADIUS config %q", path)
+
+	return radiusAuthBackendRead(d, meta)
+}
+
+func radiusAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	d.Set("path", path)
+
+	authMount := auths[strings.Trim(path, "/")+"/"]
+	if authMount == nil {
+		return fmt.Errorf("auth mount %s not present", path)
+	}
+
+	d.Set("description", authMount.Description)
+	d.Set("accessor", authMount.Accessor)
+
+	path = radiusAuthBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Reading RADIUS auth backend config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading radius auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read RADIUS auth backend config %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] RADIUS auth backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+
+	d.Set("host", resp.Data["host"])
+	d.Set("port", resp.Data["port"])
+	d.Set("dial_timeout", resp.Data["dial_timeout"])
+	d.Set("read_timeout", resp.Data["read_timeout"])
+	d.Set("nas_port", resp.Data["nas_port"])
+	d.Set("nas_identifier", resp.Data["nas_identifier"])
+
+	policySet := schema.NewSet(schema.HashString, []interface{}{})
+	// Vault stores `unregistered_user_policies` as a comma-separated string.
+	if v, ok := resp.Data["unregistered_user_policies"].(string); ok && v != "" {
+		for _, policy := range strings.Split(v, ",") {
+			policySet.Add(policy)
+		}
+	}
+	d.Set("unregistered_user_policies", policySet)
+
+	// `secret` cannot be read out from the API
+	// So... if they drift, they drift.
+
+	return nil
+}
+
+func radiusAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting RADIUS auth backend %q", path)
+	err := client.Sys().DisableAuth(path)
+	if err != nil {
+		return fmt.Errorf("error deleting radius auth backend %q: %q", path, err)
+	}
+	log.Printf("[DEBUG] Deleted RADIUS auth backend %q", path)
+
+	return nil
+}
+
+func radiusAuthBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := radiusAuthBackendConfigPath(d.Id())
+
+	log.Printf("[DEBUG] Checking if RADIUS auth backend %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of radius config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if RADIUS auth backend %q exists", path)
+
+	return resp != nil, nil
+}
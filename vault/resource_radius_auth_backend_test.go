@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRadiusAuthBackend_basic(t *testing.T) {
+	host := os.Getenv("RADIUS_HOST")
+	secret := os.Getenv("RADIUS_SECRET")
+	if host == "" {
+		t.Skip("RADIUS_HOST not set")
+	}
+	if secret == "" {
+		t.Skip("RADIUS_SECRET not set")
+	}
+	path := acctest.RandomWithPrefix("tf-test-radius")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRadiusAuthBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRadiusAuthBackendConfig_basic(path, host, secret, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_radius_auth_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_radius_auth_backend.test", "host", host),
+					resource.TestCheckResourceAttr("vault_radius_auth_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttrSet("vault_radius_auth_backend.test", "accessor"),
+				),
+			},
+			{
+				Config: testAccRadiusAuthBackendConfig_basic(path, host, secret, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_radius_auth_backend.test", "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRadiusAuthBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_radius_auth_backend" {
+			continue
+		}
+		for path := range auths {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("auth backend %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccRadiusAuthBackendConfig_basic(path, host, secret, description string) string {
+	return fmt.Sprintf(`
+resource "vault_radius_auth_backend" "test" {
+  path = "%s"
+  description = "%s"
+  host = "%s"
+  secret = "%s"
+}
+`, path, description, host, secret)
+}
@@ -0,0 +1,145 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const raftAutopilotPath = "sys/storage/raft/autopilot/configuration"
+
+const (
+	raftAutopilotDefaultCleanupDeadServers             = false
+	raftAutopilotDefaultDeadServerLastContactThreshold = "24h0m0s"
+	raftAutopilotDefaultLastContactThreshold           = "10s"
+	raftAutopilotDefaultMaxTrailingLogs                = 1000
+	raftAutopilotDefaultMinQuorum                      = 3
+	raftAutopilotDefaultServerStabilizationTime        = "10s"
+)
+
+func raftAutopilotResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftAutopilotWrite,
+		Update: raftAutopilotWrite,
+		Read:   raftAutopilotRead,
+		Delete: raftAutopilotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cleanup_dead_servers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     raftAutopilotDefaultCleanupDeadServers,
+				Description: "Specifies whether to remove dead server nodes periodically or when a new server joins.",
+			},
+			"dead_server_last_contact_threshold": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     raftAutopilotDefaultDeadServerLastContactThreshold,
+				Description: "Limit the amount of time a server can go without leader contact before being considered failed. This only takes effect when cleanup_dead_servers is set.",
+			},
+			"last_contact_threshold": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     raftAutopilotDefaultLastContactThreshold,
+				Description: "Limit the amount of time a server can go without leader contact before being considered unhealthy.",
+			},
+			"max_trailing_logs": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     raftAutopilotDefaultMaxTrailingLogs,
+				Description: "Maximum number of log entries in the Raft log that a server can be behind its leader before being considered unhealthy.",
+			},
+			"min_quorum": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     raftAutopilotDefaultMinQuorum,
+				Description: "Minimum number of servers allowed in a cluster before autopilot can prune dead servers. This should at least be 3.",
+			},
+			"server_stabilization_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     raftAutopilotDefaultServerStabilizationTime,
+				Description: "Minimum amount of time a server must be stable in the 'healthy' state before being added to the cluster.",
+			},
+		},
+	}
+}
+
+func raftAutopilotWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"cleanup_dead_servers":               d.Get("cleanup_dead_servers").(bool),
+		"dead_server_last_contact_threshold": d.Get("dead_server_last_contact_threshold").(string),
+		"last_contact_threshold":             d.Get("last_contact_threshold").(string),
+		"max_trailing_logs":                  d.Get("max_trailing_logs").(int),
+		"min_quorum":                         d.Get("min_quorum").(int),
+		"server_stabilization_time":          d.Get("server_stabilization_time").(string),
+	}
+
+	log.Printf("[DEBUG] Writing raft autopilot config %q", raftAutopilotPath)
+	_, err := client.Logical().Write(raftAutopilotPath, data)
+	if err != nil {
+		return fmt.Errorf("error writing raft autopilot config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote raft autopilot config %q", raftAutopilotPath)
+
+	d.SetId(raftAutopilotPath)
+
+	return raftAutopilotRead(d, meta)
+}
+
+func raftAutopilotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading raft autopilot config %q", raftAutopilotPath)
+	resp, err := client.Logical().Read(raftAutopilotPath)
+	if err != nil {
+		return fmt.Errorf("error reading raft autopilot config: %s", err)
+	}
+	log.Printf("[DEBUG] Read raft autopilot config %q", raftAutopilotPath)
+
+	if resp == nil {
+		log.Printf("[WARN] Raft autopilot config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cleanup_dead_servers", resp.Data["cleanup_dead_servers"])
+	d.Set("dead_server_last_contact_threshold", resp.Data["dead_server_last_contact_threshold"])
+	d.Set("last_contact_threshold", resp.Data["last_contact_threshold"])
+	d.Set("max_trailing_logs", resp.Data["max_trailing_logs"])
+	d.Set("min_quorum", resp.Data["min_quorum"])
+	d.Set("server_stabilization_time", resp.Data["server_stabilization_time"])
+
+	return nil
+}
+
+func raftAutopilotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// The autopilot configuration cannot be removed, so deleting the
+	// resource restores the default configuration.
+	data := map[string]interface{}{
+		"cleanup_dead_servers":               raftAutopilotDefaultCleanupDeadServers,
+		"dead_server_last_contact_threshold": raftAutopilotDefaultDeadServerLastContactThreshold,
+		"last_contact_threshold":             raftAutopilotDefaultLastContactThreshold,
+		"max_trailing_logs":                  raftAutopilotDefaultMaxTrailingLogs,
+		"min_quorum":                         raftAutopilotDefaultMinQuorum,
+		"server_stabilization_time":          raftAutopilotDefaultServerStabilizationTime,
+	}
+
+	log.Printf("[DEBUG] Restoring default raft autopilot config %q", raftAutopilotPath)
+	_, err := client.Logical().Write(raftAutopilotPath, data)
+	if err != nil {
+		return fmt.Errorf("error restoring default raft autopilot config: %s", err)
+	}
+	log.Printf("[DEBUG] Restored default raft autopilot config %q", raftAutopilotPath)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccRaftAutopilot_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_RAFT") == "" {
+		t.Skip("TF_ACC_RAFT is not set, test is applicable only for Vault with integrated (raft) storage")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftAutopilotConfig_basic(true, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_raft_autopilot.test", "cleanup_dead_servers", "true"),
+					resource.TestCheckResourceAttr("vault_raft_autopilot.test", "min_quorum", "3"),
+				),
+			},
+			{
+				Config: testAccRaftAutopilotConfig_basic(false, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_raft_autopilot.test", "cleanup_dead_servers", "false"),
+					resource.TestCheckResourceAttr("vault_raft_autopilot.test", "min_quorum", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRaftAutopilotConfig_basic(cleanupDeadServers bool, minQuorum int) string {
+	return fmt.Sprintf(`
+resource "vault_raft_autopilot" "test" {
+  cleanup_dead_servers = %t
+  min_quorum = %d
+}
+`, cleanupDeadServers, minQuorum)
+}
@@ -0,0 +1,298 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func raftSnapshotAgentConfigPath(name string) string {
+	return "sys/storage/raft/snapshot-auto/config/" + name
+}
+
+var raftSnapshotAgentStorageFields = map[string][]string{
+	"local": {
+		"local_max_space",
+	},
+	"aws-s3": {
+		"aws_s3_bucket",
+		"aws_s3_region",
+		"aws_access_key_id",
+		"aws_secret_access_key",
+		"aws_session_token",
+		"aws_s3_endpoint",
+		"aws_s3_disable_tls",
+		"aws_s3_force_path_style",
+		"aws_s3_enable_kms",
+		"aws_s3_kms_key",
+		"aws_s3_server_side_encryption",
+	},
+	"google-gcs": {
+		"google_gcs_bucket",
+		"google_service_account_key",
+		"google_endpoint",
+		"google_disable_tls",
+	},
+	"azure-blob": {
+		"azure_container_name",
+		"azure_account_name",
+		"azure_account_key",
+		"azure_blob_environment",
+		"azure_endpoint",
+	},
+}
+
+func raftSnapshotAgentConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftSnapshotAgentConfigWrite,
+		Update: raftSnapshotAgentConfigWrite,
+		Read:   raftSnapshotAgentConfigRead,
+		Delete: raftSnapshotAgentConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the snapshot agent configuration.",
+			},
+			"interval_seconds": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of seconds between snapshots.",
+			},
+			"retain": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "How many snapshots are to be kept.",
+			},
+			"path_prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The directory or bucket prefix to use for snapshot storage.",
+			},
+			"file_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vault-snapshot",
+				Description: "Within the directory or bucket prefix given by path_prefix, the file or object name of snapshot files will start with this string.",
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "What storage service to send snapshots to.",
+				ValidateFunc: validation.StringInSlice([]string{"local", "azure-blob", "aws-s3", "google-gcs"}, false),
+			},
+			"local_max_space": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum space, in bytes, to use for snapshots.",
+			},
+			"aws_s3_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "S3 bucket to write snapshots to.",
+			},
+			"aws_s3_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS region bucket is in.",
+			},
+			"aws_access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS access key ID.",
+			},
+			"aws_secret_access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret access key.",
+			},
+			"aws_session_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS session token.",
+			},
+			"aws_s3_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS endpoint. This is typically only set when using a non-AWS S3 implementation like Minio.",
+			},
+			"aws_s3_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the S3 endpoint. This should only be used for testing purposes.",
+			},
+			"aws_s3_force_path_style": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use the endpoint/bucket URL style instead of bucket.endpoint.",
+			},
+			"aws_s3_enable_kms": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use KMS to encrypt bucket contents.",
+			},
+			"aws_s3_kms_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Use named KMS key, when aws_s3_enable_kms = true.",
+			},
+			"aws_s3_server_side_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use AES256 to encrypt bucket contents.",
+			},
+			"google_gcs_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCS bucket to write snapshots to.",
+			},
+			"google_service_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Google service account key in JSON format.",
+			},
+			"google_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCS endpoint. This is typically only set when using a non-Google GCS implementation like fake-gcs-server.",
+			},
+			"google_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the GCS endpoint.",
+			},
+			"azure_container_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure container name to write snapshots to.",
+			},
+			"azure_account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure account name.",
+			},
+			"azure_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Azure account key.",
+			},
+			"azure_blob_environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure blob environment.",
+			},
+			"azure_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure blob storage endpoint. This is typically only set when using a non-Azure implementation like Azurite.",
+			},
+		},
+	}
+}
+
+func raftSnapshotAgentConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := raftSnapshotAgentConfigPath(name)
+
+	storageType := d.Get("storage_type").(string)
+
+	data := map[string]interface{}{
+		"interval":     d.Get("interval_seconds").(int),
+		"retain":       d.Get("retain").(int),
+		"path_prefix":  d.Get("path_prefix").(string),
+		"file_prefix":  d.Get("file_prefix").(string),
+		"storage_type": storageType,
+	}
+	for _, k := range raftSnapshotAgentStorageFields[storageType] {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing raft snapshot agent config %q", name)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing raft snapshot agent config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote raft snapshot agent config %q", name)
+
+	d.SetId(name)
+
+	return raftSnapshotAgentConfigRead(d, meta)
+}
+
+func raftSnapshotAgentConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := raftSnapshotAgentConfigPath(name)
+
+	log.Printf("[DEBUG] Reading raft snapshot agent config %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading raft snapshot agent config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read raft snapshot agent config %q", name)
+
+	if resp == nil {
+		log.Printf("[WARN] Raft snapshot agent config %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"retain", "path_prefix", "file_prefix", "storage_type"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	if v, ok := resp.Data["interval"].(json.Number); ok {
+		interval, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing interval for raft snapshot agent config %q: %s", name, err)
+		}
+		d.Set("interval_seconds", interval)
+	}
+
+	storageType, _ := resp.Data["storage_type"].(string)
+	for _, k := range raftSnapshotAgentStorageFields[storageType] {
+		// credential fields are not returned by the API, so the values in
+		// the config are left untouched.
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func raftSnapshotAgentConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := raftSnapshotAgentConfigPath(name)
+
+	log.Printf("[DEBUG] Deleting raft snapshot agent config %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting raft snapshot agent config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted raft snapshot agent config %q", name)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRaftSnapshotAgentConfig_basic(t *testing.T) {
+	if os.Getenv("TF_ACC_RAFT") == "" {
+		t.Skip("TF_ACC_RAFT is not set, test is applicable only for Vault with integrated (raft) storage")
+	}
+	name := acctest.RandomWithPrefix("tf-test-snapshot")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRaftSnapshotAgentConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotAgentConfigConfig_basic(name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_raft_snapshot_agent_config.test", "name", name),
+					resource.TestCheckResourceAttr("vault_raft_snapshot_agent_config.test", "interval_seconds", "3600"),
+					resource.TestCheckResourceAttr("vault_raft_snapshot_agent_config.test", "storage_type", "local"),
+				),
+			},
+			{
+				Config: testAccRaftSnapshotAgentConfigConfig_basic(name, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_raft_snapshot_agent_config.test", "interval_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_raft_snapshot_agent_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRaftSnapshotAgentConfigCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_raft_snapshot_agent_config" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/storage/raft/snapshot-auto/config/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("snapshot agent config %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRaftSnapshotAgentConfigConfig_basic(name string, intervalSeconds int) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot_agent_config" "test" {
+  name = "%s"
+  interval_seconds = %d
+  retain = 2
+  path_prefix = "/tmp/snapshots"
+  storage_type = "local"
+  local_max_space = 1073741824
+}
+`, name, intervalSeconds)
+}
@@ -0,0 +1,227 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func rateLimitQuotaPath(name string) string {
+	return "sys/quotas/rate-limit/" + name
+}
+
+func rateLimitQuotaResource() *schema.Resource {
+	return &schema.Resource{
+		Create: rateLimitQuotaCreate,
+		Read:   rateLimitQuotaRead,
+		Update: rateLimitQuotaUpdate,
+		Delete: rateLimitQuotaDelete,
+		Exists: rateLimitQuotaExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the quota.",
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of the mount or namespace to apply the quota. A blank path configures a global rate limit quota. " +
+					"For example namespace1/ adds a quota to a full namespace, namespace1/auth/userpass adds a quota to userpass in namespace1. " +
+					"Updating this field on an existing quota can have \"moving\" effects. For example, updating auth/userpass to " +
+					"namespace1/auth/userpass moves this quota from being a global mount quota to a namespace specific mount quota.",
+			},
+			"rate": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(float64)
+					if value <= 0 {
+						errs = append(errs, fmt.Errorf("rate must be greater than 0, got %f", value))
+					}
+					return
+				},
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The duration in seconds to enforce rate limiting for.",
+			},
+			"block_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "If set, when a client reaches a rate limit threshold, the client will be prohibited from any further requests until after the 'block_interval' in seconds has elapsed.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace.",
+			},
+			"group_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute used to group requests for rate limiting. Limits are enforced independently for each group. Valid group_by modes are: 'ip', 'none', 'entity_then_ip' or 'entity_then_none'.",
+			},
+		},
+	}
+}
+
+func rateLimitQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := rateLimitQuotaPath(name)
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Creating Resource Rate Limit Quota %s", name)
+
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["rate"] = d.Get("rate").(float64)
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(int)
+	}
+	if v, ok := d.GetOk("block_interval"); ok {
+		data["block_interval"] = v.(int)
+	}
+	if v, ok := d.GetOk("role"); ok {
+		data["role"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("inheritable"); ok {
+		data["inheritable"] = v.(bool)
+	}
+	if v, ok := d.GetOk("group_by"); ok {
+		data["group_by"] = v.(string)
+	}
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("error creating Resource Rate Limit Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Created Resource Rate Limit Quota %s", name)
+
+	return rateLimitQuotaRead(d, meta)
+}
+
+func rateLimitQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := rateLimitQuotaPath(name)
+
+	log.Printf("[DEBUG] Reading Resource Rate Limit Quota %s", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Resource Rate Limit Quota %s: %s", name, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Resource Rate Limit Quota %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"path", "interval", "block_interval", "role", "inheritable", "group_by"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	if v, ok := resp.Data["rate"]; ok {
+		rate, err := v.(json.Number).Float64()
+		if err != nil {
+			return fmt.Errorf("error parsing rate for Resource Rate Limit Quota %s: %s", name, err)
+		}
+		d.Set("rate", rate)
+	}
+
+	return nil
+}
+
+func rateLimitQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := rateLimitQuotaPath(name)
+
+	log.Printf("[DEBUG] Updating Resource Rate Limit Quota %s", name)
+
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["rate"] = d.Get("rate").(float64)
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(int)
+	}
+	if v, ok := d.GetOk("block_interval"); ok {
+		data["block_interval"] = v.(int)
+	}
+	if v, ok := d.GetOk("role"); ok {
+		data["role"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("inheritable"); ok {
+		data["inheritable"] = v.(bool)
+	}
+	if v, ok := d.GetOk("group_by"); ok {
+		data["group_by"] = v.(string)
+	}
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating Resource Rate Limit Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Updated Resource Rate Limit Quota %s", name)
+
+	return rateLimitQuotaRead(d, meta)
+}
+
+func rateLimitQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := rateLimitQuotaPath(name)
+
+	log.Printf("[DEBUG] Deleting Resource Rate Limit Quota %s", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Resource Rate Limit Quota %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted Resource Rate Limit Quota %s", name)
+
+	return nil
+}
+
+func rateLimitQuotaExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := rateLimitQuotaPath(name)
+
+	log.Printf("[DEBUG] Checking if Resource Rate Limit Quota %s exists", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Resource Rate Limit Quota %s exists: %s", name, err)
+	}
+	log.Printf("[DEBUG] Checked if Resource Rate Limit Quota %s exists", name)
+
+	return resp != nil, nil
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRateLimitQuota_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-quota")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRateLimitQuotaCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRateLimitQuotaConfig_basic(name, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_rate_limit_quota.test", "name", name),
+					resource.TestCheckResourceAttr("vault_rate_limit_quota.test", "rate", "100"),
+					resource.TestCheckResourceAttr("vault_rate_limit_quota.test", "path", ""),
+				),
+			},
+			{
+				Config: testAccRateLimitQuotaConfig_basic(name, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_rate_limit_quota.test", "rate", "200"),
+				),
+			},
+			{
+				ResourceName:      "vault_rate_limit_quota.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRateLimitQuotaCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_rate_limit_quota" {
+			continue
+		}
+		secret, err := client.Logical().Read("sys/quotas/rate-limit/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("rate limit quota %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRateLimitQuotaConfig_basic(name, rate string) string {
+	return fmt.Sprintf(`
+resource "vault_rate_limit_quota" "test" {
+  name = "%s"
+  rate = %s
+}
+`, name, rate)
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var replicationModes = []string{"performance", "dr"}
+
+func replicationPrimaryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationPrimaryCreate,
+		Update: replicationPrimaryUpdate,
+		Read:   replicationPrimaryRead,
+		Delete: replicationPrimaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The replication mode to enable; either `performance` or `dr`.",
+				ValidateFunc: validation.StringInSlice(replicationModes, false),
+			},
+			"primary_cluster_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The cluster address that secondaries should connect to, overriding the cluster's own address.",
+			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, disables replication when the resource is destroyed. By default the cluster is left as a primary so a destroy never silently demotes it.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication cluster ID.",
+			},
+		},
+	}
+}
+
+func replicationPrimaryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Get("mode").(string)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("primary_cluster_addr"); ok {
+		data["primary_cluster_addr"] = v.(string)
+	}
+
+	path := "sys/replication/" + mode + "/primary/enable"
+	log.Printf("[DEBUG] Enabling %s replication primary", mode)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error enabling %s replication primary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Enabled %s replication primary", mode)
+
+	d.SetId(mode)
+
+	return replicationPrimaryRead(d, meta)
+}
+
+func replicationPrimaryUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only disable_on_destroy can change in place, and it is not sent to Vault.
+	return replicationPrimaryRead(d, meta)
+}
+
+func replicationPrimaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Id()
+
+	log.Printf("[DEBUG] Reading %s replication status", mode)
+	resp, err := client.Logical().Read("sys/replication/" + mode + "/status")
+	if err != nil {
+		return fmt.Errorf("error reading %s replication status: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Read %s replication status", mode)
+
+	if resp == nil || resp.Data["mode"] != "primary" {
+		log.Printf("[WARN] Cluster is not a %s replication primary, removing from state", mode)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mode", mode)
+	if v, ok := resp.Data["cluster_id"]; ok {
+		d.Set("cluster_id", v)
+	}
+
+	return nil
+}
+
+func replicationPrimaryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Id()
+
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("[WARN] disable_on_destroy is false, leaving %s replication enabled", mode)
+		return nil
+	}
+
+	path := "sys/replication/" + mode + "/primary/disable"
+	log.Printf("[DEBUG] Disabling %s replication primary", mode)
+	_, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return fmt.Errorf("error disabling %s replication primary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Disabled %s replication primary", mode)
+
+	return nil
+}
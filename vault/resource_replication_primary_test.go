@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// Enabling replication reconfigures the whole cluster, so these tests are
+// additionally gated behind TF_ACC_REPLICATION to keep them away from shared
+// enterprise test clusters.
+func testAccReplicationPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	if os.Getenv("TF_ACC_REPLICATION") == "" {
+		t.Skip("TF_ACC_REPLICATION is not set, test reconfigures cluster replication")
+	}
+}
+
+func TestAccReplicationPrimary_basic(t *testing.T) {
+	testAccReplicationPreCheck(t)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationPrimaryConfig_basic("performance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_replication_primary.test", "mode", "performance"),
+					resource.TestCheckResourceAttrSet("vault_replication_primary.test", "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationPrimaryConfig_basic(mode string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  mode = "%s"
+  disable_on_destroy = true
+}
+`, mode)
+}
@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationSecondaryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationSecondaryCreate,
+		Update: replicationSecondaryUpdate,
+		Read:   replicationSecondaryRead,
+		Delete: replicationSecondaryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The replication mode to activate; either `performance` or `dr`.",
+				ValidateFunc: validation.StringInSlice(replicationModes, false),
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The wrapped activation token generated on the primary.",
+			},
+			"primary_api_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The API address of the primary, overriding the address embedded in the token.",
+			},
+			"ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The path to a CA certificate file used to verify the primary's API address.",
+			},
+			"ca_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The path to a directory of CA certificates used to verify the primary's API address.",
+			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, disables replication when the resource is destroyed. By default the cluster is left as a secondary so a destroy never silently promotes it.",
+			},
+		},
+	}
+}
+
+func replicationSecondaryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Get("mode").(string)
+
+	data := map[string]interface{}{
+		"token": d.Get("token").(string),
+	}
+	for _, k := range []string{"primary_api_addr", "ca_file", "ca_path"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	path := "sys/replication/" + mode + "/secondary/enable"
+	log.Printf("[DEBUG] Enabling %s replication secondary", mode)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error enabling %s replication secondary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Enabled %s replication secondary", mode)
+
+	d.SetId(mode)
+
+	return replicationSecondaryRead(d, meta)
+}
+
+func replicationSecondaryUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only disable_on_destroy can change in place, and it is not sent to Vault.
+	return replicationSecondaryRead(d, meta)
+}
+
+func replicationSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Id()
+
+	log.Printf("[DEBUG] Reading %s replication status", mode)
+	resp, err := client.Logical().Read("sys/replication/" + mode + "/status")
+	if err != nil {
+		return fmt.Errorf("error reading %s replication status: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Read %s replication status", mode)
+
+	if resp == nil || resp.Data["mode"] != "secondary" {
+		log.Printf("[WARN] Cluster is not a %s replication secondary, removing from state", mode)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mode", mode)
+
+	return nil
+}
+
+func replicationSecondaryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Id()
+
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("[WARN] disable_on_destroy is false, leaving %s replication enabled", mode)
+		return nil
+	}
+
+	path := "sys/replication/" + mode + "/secondary/disable"
+	log.Printf("[DEBUG] Disabling %s replication secondary", mode)
+	_, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return fmt.Errorf("error disabling %s replication secondary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Disabled %s replication secondary", mode)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccReplicationSecondary_basic(t *testing.T) {
+	testAccReplicationPreCheck(t)
+	// Activating a secondary wipes the cluster's storage, so the activation
+	// token has to be minted against a dedicated primary out of band.
+	token := os.Getenv("REPLICATION_SECONDARY_TOKEN")
+	if token == "" {
+		t.Skip("REPLICATION_SECONDARY_TOKEN not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSecondaryConfig_basic(token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_replication_secondary.test", "mode", "performance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationSecondaryConfig_basic(token string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_secondary" "test" {
+  mode = "performance"
+  token = "%s"
+  disable_on_destroy = true
+}
+`, token)
+}
@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationSecondaryTokenResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationSecondaryTokenCreate,
+		Read:   replicationSecondaryTokenRead,
+		Delete: replicationSecondaryTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The replication mode the token is for; either `performance` or `dr`.",
+				ValidateFunc: validation.StringInSlice(replicationModes, false),
+			},
+			"secondary_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "An opaque identifier for the secondary cluster.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "30m",
+				Description: "The TTL of the wrapped activation token.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The wrapped activation token for the secondary.",
+			},
+			"wrapping_accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the wrapping token.",
+			},
+		},
+	}
+}
+
+func replicationSecondaryTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Get("mode").(string)
+	id := d.Get("secondary_id").(string)
+
+	data := map[string]interface{}{
+		"id":  id,
+		"ttl": d.Get("ttl").(string),
+	}
+
+	path := "sys/replication/" + mode + "/primary/secondary-token"
+	log.Printf("[DEBUG] Generating %s replication secondary token for %q", mode, id)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating %s replication secondary token for %q: %s", mode, id, err)
+	}
+	if resp == nil || resp.WrapInfo == nil {
+		return fmt.Errorf("no wrapped token returned for %s replication secondary %q", mode, id)
+	}
+	log.Printf("[DEBUG] Generated %s replication secondary token for %q", mode, id)
+
+	d.SetId(mode + "/" + id)
+	d.Set("token", resp.WrapInfo.Token)
+	d.Set("wrapping_accessor", resp.WrapInfo.Accessor)
+
+	return replicationSecondaryTokenRead(d, meta)
+}
+
+func replicationSecondaryTokenRead(d *schema.ResourceData, meta interface{}) error {
+	// The activation token is single-use and cannot be read back from Vault,
+	// so the value generated at create time is kept in state as-is.
+	return nil
+}
+
+func replicationSecondaryTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	mode := d.Get("mode").(string)
+	id := d.Get("secondary_id").(string)
+
+	data := map[string]interface{}{
+		"id": id,
+	}
+
+	path := "sys/replication/" + mode + "/primary/revoke-secondary"
+	log.Printf("[DEBUG] Revoking %s replication secondary %q", mode, id)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error revoking %s replication secondary %q: %s", mode, id, err)
+	}
+	log.Printf("[DEBUG] Revoked %s replication secondary %q", mode, id)
+
+	return nil
+}
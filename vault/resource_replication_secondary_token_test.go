@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccReplicationSecondaryToken_basic(t *testing.T) {
+	testAccReplicationPreCheck(t)
+	secondaryID := acctest.RandomWithPrefix("tf-test-secondary")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSecondaryTokenConfig_basic(secondaryID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_replication_secondary_token.test", "mode", "performance"),
+					resource.TestCheckResourceAttr("vault_replication_secondary_token.test", "secondary_id", secondaryID),
+					resource.TestCheckResourceAttrSet("vault_replication_secondary_token.test", "token"),
+					resource.TestCheckResourceAttrSet("vault_replication_secondary_token.test", "wrapping_accessor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationSecondaryTokenConfig_basic(secondaryID string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  mode = "performance"
+  disable_on_destroy = true
+}
+
+resource "vault_replication_secondary_token" "test" {
+  mode = "${vault_replication_primary.test.mode}"
+  secondary_id = "%s"
+  ttl = "30m"
+}
+`, secondaryID)
+}
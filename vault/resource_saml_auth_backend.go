@@ -0,0 +1,221 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const samlAuthType string = "saml"
+
+func samlAuthBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: samlAuthBackendWrite,
+		Update: samlAuthBackendUpdate,
+		Read:   samlAuthBackendRead,
+		Delete: samlAuthBackendDelete,
+		Exists: samlAuthBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "saml",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"idp_metadata_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"idp_sso_url"},
+				Description:   "The metadata URL of the identity provider.",
+			},
+			"idp_sso_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"idp_metadata_url"},
+				Description:   "The SSO URL of the identity provider. Mutually exclusive with idp_metadata_url.",
+			},
+			"idp_entity_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The entity ID of the identity provider. Mutually exclusive with idp_metadata_url.",
+			},
+			"idp_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded certificate of the identity provider. Mutually exclusive with idp_metadata_url.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity ID of the SAML authentication service provider.",
+			},
+			"acs_urls": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				Description: "The well-formatted URLs of your Assertion Consumer Service (ACS) that should receive a response from the identity provider.",
+			},
+			"default_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role to use if no role is provided during login.",
+			},
+			"verbose_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Log additional, potentially sensitive information during the SAML exchange according to the current logging level. Not recommended for production.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the SAML auth backend",
+			},
+		},
+	}
+}
+
+var matchingSamlMountConfigOptions = []string{
+	"idp_metadata_url",
+	"idp_sso_url",
+	"idp_entity_id",
+	"idp_cert",
+	"entity_id",
+	"acs_urls",
+	"default_role",
+	"verbose_logging",
+}
+
+func samlAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
+
+func samlAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	desc := d.Get("description").(string)
+
+	log.Printf("[DEBUG] Enabling SAML auth backend %q", path)
+	err := client.Sys().EnableAuth(path, samlAuthType, desc)
+	if err != nil {
+		return fmt.Errorf("error enabling saml auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled SAML auth backend %q", path)
+
+	d.SetId(path)
+
+	return samlAuthBackendUpdate(d, meta)
+}
+
+func samlAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := samlAuthBackendConfigPath(d.Id())
+
+	data := map[string]interface{}{}
+	for _, configOption := range matchingSamlMountConfigOptions {
+		// Set the configuration if the user has specified it, or the attribute is in the Diff
+		if _, ok := d.GetOkExists(configOption); ok || d.HasChange(configOption) {
+			data[configOption] = d.Get(configOption)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing SAML config %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing saml config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SAML config %q", path)
+
+	return samlAuthBackendRead(d, meta)
+}
+
+func samlAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	d.Set("path", path)
+
+	authMount := auths[strings.Trim(path, "/")+"/"]
+	if authMount == nil {
+		log.Printf("[WARN] SAML auth backend %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", authMount.Description)
+	d.Set("accessor", authMount.Accessor)
+
+	path = samlAuthBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Reading SAML auth backend config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading saml auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend config %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, configOption := range matchingSamlMountConfigOptions {
+		d.Set(configOption, resp.Data[configOption])
+	}
+
+	return nil
+}
+
+func samlAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting SAML auth backend %q", path)
+	err := client.Sys().DisableAuth(path)
+	if err != nil {
+		return fmt.Errorf("error deleting saml auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted SAML auth backend %q", path)
+
+	return nil
+}
+
+func samlAuthBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := samlAuthBackendConfigPath(d.Id())
+
+	log.Printf("[DEBUG] Checking if SAML auth backend %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of saml config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if SAML auth backend %q exists", path)
+
+	return resp != nil, nil
+}
@@ -0,0 +1,243 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	samlAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
+	samlAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
+)
+
+func samlAuthBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Unique name of the role.",
+		},
+		"bound_subjects": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Description: "The subject being asserted for SAML authentication.",
+		},
+		"bound_subjects_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+			Description:  "The type of matching assertion to perform on bound_subjects.",
+		},
+		"bound_attributes": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Mapping of attribute names to values that are expected to exist in the SAML assertion.",
+		},
+		"bound_attributes_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+			Description:  "The type of matching assertion to perform on bound_attributes.",
+		},
+		"groups_attribute": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The attribute to use to identify the set of groups to which the user belongs.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "saml",
+			Description: "Unique name of the auth backend to configure.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: samlAuthBackendRoleCreate,
+		Read:   samlAuthBackendRoleRead,
+		Update: samlAuthBackendRoleUpdate,
+		Delete: samlAuthBackendRoleDelete,
+		Exists: samlAuthBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func samlAuthBackendRolePath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func samlAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]interface{}, create bool) {
+	updateTokenFields(d, data, create)
+
+	if v, ok := d.GetOk("bound_subjects"); ok {
+		data["bound_subjects"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOk("bound_subjects_type"); ok {
+		data["bound_subjects_type"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("bound_attributes"); ok {
+		data["bound_attributes"] = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("bound_attributes_type"); ok {
+		data["bound_attributes_type"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("groups_attribute"); ok {
+		data["groups_attribute"] = v.(string)
+	}
+}
+
+func samlAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := samlAuthBackendRolePath(backend, name)
+
+	log.Printf("[DEBUG] Writing SAML auth backend role %q", path)
+
+	data := map[string]interface{}{}
+	samlAuthBackendRoleUpdateFields(d, data, true)
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing SAML auth backend role %q: %s", path, err)
+	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote SAML auth backend role %q", path)
+
+	return samlAuthBackendRoleRead(d, meta)
+}
+
+func samlAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := samlAuthBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for SAML auth backend role: %s", path, err)
+	}
+
+	name, err := samlAuthBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for SAML auth backend role: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading SAML auth backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	readTokenFields(d, resp)
+
+	for _, k := range []string{"bound_subjects", "bound_subjects_type", "bound_attributes", "bound_attributes_type", "groups_attribute"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\": %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func samlAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Updating SAML auth backend role %q", path)
+
+	data := map[string]interface{}{}
+	samlAuthBackendRoleUpdateFields(d, data, false)
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated SAML auth backend role %q", path)
+
+	return samlAuthBackendRoleRead(d, meta)
+}
+
+func samlAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting SAML auth backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted SAML auth backend role %q", path)
+
+	return nil
+}
+
+func samlAuthBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if SAML auth backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if SAML auth backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if SAML auth backend role %q exists", path)
+
+	return resp != nil, nil
+}
+
+func samlAuthBackendRoleNameFromPath(path string) (string, error) {
+	if !samlAuthBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := samlAuthBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func samlAuthBackendRoleBackendFromPath(path string) (string, error) {
+	if !samlAuthBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := samlAuthBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
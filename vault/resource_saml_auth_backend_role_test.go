@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSAMLAuthBackendRole_basic(t *testing.T) {
+	metadataURL := getTestSAMLMetadataURL(t)
+	backend := acctest.RandomWithPrefix("tf-test-saml")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSAMLAuthBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendRoleConfig_basic(backend, metadataURL, name, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_saml_auth_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_saml_auth_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_saml_auth_backend_role.test", "bound_subjects.0", "*@example.com"),
+					resource.TestCheckResourceAttr("vault_saml_auth_backend_role.test", "token_policies.0", "dev"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendRoleConfig_basic(backend, metadataURL, name, "ops"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_saml_auth_backend_role.test", "token_policies.0", "ops"),
+				),
+			},
+			{
+				ResourceName:      "vault_saml_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSAMLAuthBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_saml_auth_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSAMLAuthBackendRoleConfig_basic(backend, metadataURL, name, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_saml_auth_backend" "test" {
+  path = "%s"
+  idp_metadata_url = "%s"
+  entity_id = "https://my.vault/v1/auth/saml"
+  acs_urls = ["https://my.vault/v1/auth/saml/callback"]
+}
+
+resource "vault_saml_auth_backend_role" "test" {
+  backend = "${vault_saml_auth_backend.test.path}"
+  name = "%s"
+  bound_subjects = ["*@example.com"]
+  bound_subjects_type = "glob"
+  token_policies = ["%s"]
+}
+`, backend, metadataURL, name, policy)
+}
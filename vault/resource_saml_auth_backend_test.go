@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestSAMLMetadataURL(t *testing.T) string {
+	metadataURL := os.Getenv("SAML_IDP_METADATA_URL")
+	if metadataURL == "" {
+		t.Skip("SAML_IDP_METADATA_URL not set")
+	}
+	return metadataURL
+}
+
+func TestAccSAMLAuthBackend_basic(t *testing.T) {
+	metadataURL := getTestSAMLMetadataURL(t)
+	path := acctest.RandomWithPrefix("tf-test-saml")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSAMLAuthBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendConfig_basic(path, metadataURL, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_saml_auth_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_saml_auth_backend.test", "idp_metadata_url", metadataURL),
+					resource.TestCheckResourceAttr("vault_saml_auth_backend.test", "description", "test description"),
+					resource.TestCheckResourceAttrSet("vault_saml_auth_backend.test", "accessor"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendConfig_basic(path, metadataURL, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_saml_auth_backend.test", "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:      "vault_saml_auth_backend.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSAMLAuthBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_saml_auth_backend" {
+			continue
+		}
+		for path := range auths {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("auth backend %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccSAMLAuthBackendConfig_basic(path, metadataURL, description string) string {
+	return fmt.Sprintf(`
+resource "vault_saml_auth_backend" "test" {
+  path = "%s"
+  description = "%s"
+  idp_metadata_url = "%s"
+  entity_id = "https://my.vault/v1/auth/saml"
+  acs_urls = ["https://my.vault/v1/auth/saml/callback"]
+}
+`, path, description, metadataURL)
+}
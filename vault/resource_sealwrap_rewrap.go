@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const sealwrapRewrapPath = "sys/sealwrap/rewrap"
+
+func sealwrapRewrapResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sealwrapRewrapCreate,
+		Read:   sealwrapRewrapRead,
+		Delete: sealwrapRewrapDelete,
+
+		Schema: map[string]*schema.Schema{
+			"is_running": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rewrap process is currently running.",
+			},
+		},
+	}
+}
+
+func sealwrapRewrapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Starting seal rewrap %q", sealwrapRewrapPath)
+	_, err := client.Logical().Write(sealwrapRewrapPath, nil)
+	if err != nil {
+		return fmt.Errorf("error starting seal rewrap: %s", err)
+	}
+	log.Printf("[DEBUG] Started seal rewrap %q", sealwrapRewrapPath)
+
+	d.SetId(sealwrapRewrapPath)
+
+	return sealwrapRewrapRead(d, meta)
+}
+
+func sealwrapRewrapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading seal rewrap status %q", sealwrapRewrapPath)
+	resp, err := client.Logical().Read(sealwrapRewrapPath)
+	if err != nil {
+		return fmt.Errorf("error reading seal rewrap status: %s", err)
+	}
+	log.Printf("[DEBUG] Read seal rewrap status %q", sealwrapRewrapPath)
+
+	if resp == nil {
+		log.Printf("[WARN] Seal rewrap status not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := resp.Data["is_running"].(bool); ok {
+		d.Set("is_running", v)
+	}
+
+	return nil
+}
+
+func sealwrapRewrapDelete(d *schema.ResourceData, meta interface{}) error {
+	// A rewrap cannot be undone; deleting the resource only forgets it.
+	return nil
+}
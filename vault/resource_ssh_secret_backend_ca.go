@@ -51,6 +51,33 @@ func sshSecretBackendCAResource() *schema.Resource {
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"key_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ssh-rsa",
+				Description: "Specifies the desired key type; must be ssh-rsa, rsa-sha2-256, rsa-sha2-512, ecdsa-sha2-nistp256, ecdsa-sha2-nistp384, ecdsa-sha2-nistp521, or ssh-ed25519.",
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies the desired key bits for the generated SSH CA key when generate_signing_key is set to true.",
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The name of the managed key to use. When using a managed key, this field or managed_key_id is required.",
+				ConflictsWith: []string{"managed_key_id", "private_key"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The ID of the managed key to use. When using a managed key, this field or managed_key_name is required.",
+				ConflictsWith: []string{"managed_key_name", "private_key"},
+			},
 		},
 	}
 }
@@ -69,6 +96,18 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	if publicKey, ok := d.Get("public_key").(string); ok {
 		data["public_key"] = publicKey
 	}
+	if keyType, ok := d.Get("key_type").(string); ok {
+		data["key_type"] = keyType
+	}
+	if keyBits, ok := d.GetOk("key_bits"); ok {
+		data["key_bits"] = keyBits
+	}
+	if managedKeyName, ok := d.GetOk("managed_key_name"); ok {
+		data["managed_key_name"] = managedKeyName
+	}
+	if managedKeyID, ok := d.GetOk("managed_key_id"); ok {
+		data["managed_key_id"] = managedKeyID
+	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
 	_, err := client.Logical().Write(backend+"/config/ca", data)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -100,8 +101,14 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Optional: true,
 			},
 			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ca", "otp", "dynamic"}, false),
+			},
+			"algorithm_signer": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"allowed_user_key_lengths": {
 				Type:     schema.TypeMap,
@@ -186,6 +193,10 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["ttl"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("algorithm_signer"); ok {
+		data["algorithm_signer"] = v.(string)
+	}
+
 	log.Printf("[DEBUG] Writing role %q on SSH backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -247,6 +258,9 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("allowed_user_key_lengths", role.Data["allowed_user_key_lengths"])
 	d.Set("max_ttl", role.Data["max_ttl"])
 	d.Set("ttl", role.Data["ttl"])
+	if v, ok := role.Data["algorithm_signer"]; ok {
+		d.Set("algorithm_signer", v)
+	}
 
 	return nil
 }
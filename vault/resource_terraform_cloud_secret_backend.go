@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func terraformCloudSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: terraformCloudSecretBackendCreate,
+		Read:   terraformCloudSecretBackendRead,
+		Update: terraformCloudSecretBackendUpdate,
+		Delete: terraformCloudSecretBackendDelete,
+		Exists: terraformCloudSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "terraform",
+				Description: "Unique name of the Vault Terraform Cloud mount to configure",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://app.terraform.io",
+				Description: "Specifies the address of the Terraform Cloud instance, provided as \"host:port\" like \"127.0.0.1:8500\".",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Specifies the Terraform Cloud access token to use.",
+			},
+			"base_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/api/v2/",
+				Description: "Specifies the base path for the Terraform Cloud or Enterprise API.",
+			},
+		},
+	}
+}
+
+func terraformCloudSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+	address := d.Get("address").(string)
+	token := d.Get("token").(string)
+	basePath := d.Get("base_path").(string)
+
+	configPath := terraformCloudSecretBackendConfigPath(backend)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Terraform Cloud backend at %q", backend)
+
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "terraform",
+		Description: description,
+		Config:      api.MountConfigInput{},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+
+	log.Printf("[DEBUG] Mounted Terraform Cloud backend at %q", backend)
+	d.SetId(backend)
+
+	d.SetPartial("backend")
+	d.SetPartial("description")
+
+	log.Printf("[DEBUG] Writing Terraform Cloud configuration to %q", configPath)
+	data := map[string]interface{}{
+		"address":   address,
+		"token":     token,
+		"base_path": basePath,
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Terraform Cloud configuration for %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote Terraform Cloud configuration to %q", configPath)
+	d.Partial(false)
+
+	return terraformCloudSecretBackendRead(d, meta)
+}
+
+func terraformCloudSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	configPath := terraformCloudSecretBackendConfigPath(backend)
+
+	log.Printf("[DEBUG] Reading Terraform Cloud backend mount %q from Vault", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read Terraform Cloud backend mount %q from Vault", backend)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(backend, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("description", mount.Description)
+
+	log.Printf("[DEBUG] Reading %s from Vault", configPath)
+	secret, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %s from Vault", configPath)
+
+	// token, sadly, we can't read out
+	// the API doesn't support it
+	// So... if it drifts, it drift.
+	if secret != nil {
+		d.Set("address", secret.Data["address"].(string))
+		d.Set("base_path", secret.Data["base_path"].(string))
+	}
+
+	return nil
+}
+
+func terraformCloudSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	d.Partial(true)
+
+	if d.HasChange("address") || d.HasChange("token") || d.HasChange("base_path") {
+		configPath := terraformCloudSecretBackendConfigPath(backend)
+		log.Printf("[DEBUG] Updating Terraform Cloud configuration at %q", configPath)
+		data := map[string]interface{}{
+			"address":   d.Get("address").(string),
+			"token":     d.Get("token").(string),
+			"base_path": d.Get("base_path").(string),
+		}
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error configuring Terraform Cloud configuration for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated Terraform Cloud configuration at %q", configPath)
+		d.SetPartial("address")
+		d.SetPartial("token")
+		d.SetPartial("base_path")
+	}
+	d.Partial(false)
+	return terraformCloudSecretBackendRead(d, meta)
+}
+
+func terraformCloudSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	log.Printf("[DEBUG] Unmounting Terraform Cloud backend %q", backend)
+	err := client.Sys().Unmount(backend)
+	if err != nil {
+		return fmt.Errorf("error unmounting Terraform Cloud backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted Terraform Cloud backend %q", backend)
+	return nil
+}
+
+func terraformCloudSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	log.Printf("[DEBUG] Checking if Terraform Cloud backend exists at %q", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Terraform Cloud backend exists at %q", backend)
+	_, ok := mounts[strings.Trim(backend, "/")+"/"]
+	return ok, nil
+}
+
+func terraformCloudSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
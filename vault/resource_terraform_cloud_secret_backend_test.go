@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func getTestTFCToken(t *testing.T) string {
+	token := os.Getenv("TFC_TOKEN")
+	if token == "" {
+		t.Skip("TFC_TOKEN not set")
+	}
+	return token
+}
+
+func TestAccTerraformCloudSecretBackend_basic(t *testing.T) {
+	token := getTestTFCToken(t)
+	backend := acctest.RandomWithPrefix("tf-test-terraform")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTerraformCloudSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerraformCloudSecretBackendConfig_basic(backend, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "address", "https://app.terraform.io"),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTerraformCloudSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_terraform_cloud_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["backend"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccTerraformCloudSecretBackendConfig_basic(backend, token string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  backend = "%s"
+  description = "test description"
+  token = "%s"
+}
+`, backend, token)
+}
@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	terraformCloudSecretRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	terraformCloudSecretRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+$)")
+)
+
+func terraformCloudSecretRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: terraformCloudSecretRoleWrite,
+		Read:   terraformCloudSecretRoleRead,
+		Update: terraformCloudSecretRoleWrite,
+		Delete: terraformCloudSecretRoleDelete,
+		Exists: terraformCloudSecretRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of an existing role against which to create this Terraform Cloud credential",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "terraform",
+				Description: "The path of the Terraform Cloud Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"organization": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the Terraform Cloud or Enterprise organization",
+				ConflictsWith: []string{"user_id"},
+			},
+			"team_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the Terraform Cloud or Enterprise team under organization (e.g., settings/teams/team-xxxxxxxxxxxxx)",
+				ConflictsWith: []string{"user_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the Terraform Cloud or Enterprise user (e.g., user-xxxxxxxxxxxxxxxx)",
+				ConflictsWith: []string{"organization", "team_id"},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default TTL for leases associated with this role, in seconds.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum TTL for leases associated with this role, in seconds.",
+			},
+		},
+	}
+}
+
+func terraformCloudSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := terraformCloudSecretRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"ttl":     d.Get("ttl").(int),
+		"max_ttl": d.Get("max_ttl").(int),
+	}
+	if v, ok := d.GetOk("organization"); ok {
+		data["organization"] = v.(string)
+	}
+	if v, ok := d.GetOk("team_id"); ok {
+		data["team_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("user_id"); ok {
+		data["user_id"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing role %q on Terraform Cloud backend %q", name, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing role %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote role %q on Terraform Cloud backend %q", name, backend)
+
+	d.SetId(path)
+	return terraformCloudSecretRoleRead(d, meta)
+}
+
+func terraformCloudSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := terraformCloudSecretRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Terraform Cloud role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := terraformCloudSecretRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Terraform Cloud role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading role from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read role from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"organization", "team_id", "user_id", "ttl", "max_ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func terraformCloudSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted role %q", path)
+	return nil
+}
+
+func terraformCloudSecretRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func terraformCloudSecretRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func terraformCloudSecretRoleNameFromPath(path string) (string, error) {
+	if !terraformCloudSecretRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := terraformCloudSecretRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func terraformCloudSecretRoleBackendFromPath(path string) (string, error) {
+	if !terraformCloudSecretRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := terraformCloudSecretRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTerraformCloudSecretRole_basic(t *testing.T) {
+	token := getTestTFCToken(t)
+	organization := os.Getenv("TFC_ORGANIZATION")
+	if organization == "" {
+		t.Skip("TFC_ORGANIZATION not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-terraform")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTerraformCloudSecretRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerraformCloudSecretRoleConfig_basic(backend, token, name, organization, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "organization", organization),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccTerraformCloudSecretRoleConfig_basic(backend, token, name, organization, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTerraformCloudSecretRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_terraform_cloud_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTerraformCloudSecretRoleConfig_basic(backend, token, name, organization string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  backend = "%s"
+  token = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend = "${vault_terraform_cloud_secret_backend.test.backend}"
+  name = "%s"
+  organization = "%s"
+  ttl = %d
+}
+`, backend, token, name, organization, ttl)
+}
@@ -104,6 +104,20 @@ func tokenResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "The period of the token.",
 			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Required:    false,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Metadata to be associated with the token.",
+			},
+			"rotation_version": {
+				Type:        schema.TypeInt,
+				Required:    false,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Increment this to trigger the token to be recreated.",
+			},
 			"renew_min_lease": {
 				Type:        schema.TypeInt,
 				Required:    false,
@@ -207,6 +221,14 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		createRequest.NumUses = v.(int)
 	}
 
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]string)
+		for k, val := range v.(map[string]interface{}) {
+			metadata[k] = val.(string)
+		}
+		createRequest.Metadata = metadata
+	}
+
 	if v, ok := d.GetOk("renewable"); ok {
 		renewable := v.(bool)
 		createRequest.Renewable = &renewable
@@ -321,6 +343,9 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("renewable", resp.Data["renewable"])
 	d.Set("display_name", strings.TrimPrefix(resp.Data["display_name"].(string), "token-"))
 	d.Set("num_uses", resp.Data["num_uses"])
+	if metadata, ok := resp.Data["meta"]; ok {
+		d.Set("metadata", metadata)
+	}
 	if _, ok := d.GetOk("pgp_key"); !ok {
 		d.Set("pgp_key", "")
 	}
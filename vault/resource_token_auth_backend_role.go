@@ -156,7 +156,7 @@ func tokenAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error writing Token auth backend role %q: %s", path, err)
+		return fmt.Errorf("error writing Token auth backend role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Wrote Token auth backend role %q", path)
 
@@ -169,13 +169,13 @@ func tokenAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 
 	roleName, err := tokenAuthBackendRoleNameFromPath(path)
 	if err != nil {
-		return fmt.Errorf("Invalid path %q for Token auth backend role: %s", path, err)
+		return fmt.Errorf("invalid path %q for Token auth backend role: %s", path, err)
 	}
 
 	log.Printf("[DEBUG] Reading Token auth backend role %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
-		return fmt.Errorf("Error reading Token auth backend role %q: %s", path, err)
+		return fmt.Errorf("error reading Token auth backend role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Read Token auth backend role %q", path)
 	if resp == nil {
@@ -261,7 +261,7 @@ func tokenAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Deleting Token auth backend role %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("error deleting Token auth backend role %q", path)
+		return fmt.Errorf("error deleting Token auth backend role %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Deleted Token auth backend role %q", path)
 
@@ -0,0 +1,305 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	totpSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	totpSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+$)")
+)
+
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Exists: totpSecretBackendKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the key.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "totp",
+				Description: "The mount path for the TOTP secret backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"generate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, Vault will generate a new key rather than importing an existing one.",
+			},
+			"exported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "If true, a QR code and URL are returned when the key is generated. Only used when generate is true.",
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     20,
+				Description: "The size in bytes of the generated key. Only used when generate is true.",
+			},
+			"qr_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     200,
+				Description: "The pixel size of the square QR code when generating a new key. Only used when generate and exported are true.",
+			},
+			"url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Description:   "An otpauth:// URL to import the key from, containing all of the key configuration.",
+				ConflictsWith: []string{"generate"},
+			},
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Description:   "The base32-encoded shared master key of the TOTP account to import.",
+				ConflictsWith: []string{"generate"},
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The name of the issuing organization. Required when generate is true.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The name of the account associated with the key. Required when generate is true.",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     30,
+				Description: "The length of time in seconds used to generate a counter for the TOTP code calculation.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SHA1",
+				Description:  "The hashing algorithm used to generate the TOTP code.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      6,
+				Description:  "The number of digits in the generated TOTP code, 6 or 8.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				Description:  "The number of delay periods allowed when validating a TOTP code, 0 or 1. Only used when generate is true.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A base64-encoded PNG image of a QR code for the generated key.",
+			},
+			"otpauth_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "An otpauth:// URL for the generated key.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := totpSecretBackendKeyPath(backend, name)
+
+	generate := d.Get("generate").(bool)
+	data := map[string]interface{}{
+		"generate":  generate,
+		"period":    d.Get("period").(int),
+		"algorithm": d.Get("algorithm").(string),
+		"digits":    d.Get("digits").(int),
+	}
+	if generate {
+		if d.Get("issuer").(string) == "" || d.Get("account_name").(string) == "" {
+			return fmt.Errorf("fields 'issuer' and 'account_name' are required when 'generate' is true")
+		}
+		data["exported"] = d.Get("exported").(bool)
+		data["key_size"] = d.Get("key_size").(int)
+		data["qr_size"] = d.Get("qr_size").(int)
+		data["skew"] = d.Get("skew").(int)
+	} else if d.Get("url").(string) == "" && d.Get("key").(string) == "" {
+		return fmt.Errorf("one of 'url' or 'key' must be specified when 'generate' is false")
+	}
+	if v, ok := d.GetOk("url"); ok {
+		data["url"] = v.(string)
+	}
+	if v, ok := d.GetOk("key"); ok {
+		data["key"] = v.(string)
+	}
+	if v, ok := d.GetOk("issuer"); ok {
+		data["issuer"] = v.(string)
+	}
+	if v, ok := d.GetOk("account_name"); ok {
+		data["account_name"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing key %q on TOTP backend %q", name, backend)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing key %q for backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Wrote key %q on TOTP backend %q", name, backend)
+
+	d.SetId(path)
+
+	// the QR code and URL are only returned when the key is generated, so
+	// they have to be captured here rather than on read.
+	if secret != nil {
+		if v, ok := secret.Data["barcode"]; ok {
+			d.Set("barcode", v)
+		}
+		if v, ok := secret.Data["url"]; ok {
+			d.Set("otpauth_url", v)
+		}
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	name, err := totpSecretBackendKeyNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing TOTP key %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	backend, err := totpSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing TOTP key %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+	if secret == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("backend", backend)
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key %q", path)
+	return nil
+}
+
+func totpSecretBackendKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", path)
+	return secret != nil, nil
+}
+
+func totpSecretBackendKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func totpSecretBackendKeyNameFromPath(path string) (string, error) {
+	if !totpSecretBackendKeyNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := totpSecretBackendKeyNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func totpSecretBackendKeyBackendFromPath(path string) (string, error) {
+	if !totpSecretBackendKeyBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := totpSecretBackendKeyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
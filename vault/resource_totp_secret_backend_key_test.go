@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTOTPSecretBackendKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTOTPSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig_basic(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "generate", "true"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "issuer", "test-issuer"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "account_name", "test-account"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "barcode"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "otpauth_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTOTPSecretBackendKeyCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_totp_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTOTPSecretBackendKeyConfig_basic(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = "${vault_mount.totp.path}"
+  name = "%s"
+  generate = true
+  issuer = "test-issuer"
+  account_name = "test-account"
+}
+`, backend, name)
+}
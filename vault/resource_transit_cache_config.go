@@ -15,6 +15,9 @@ func transitSecretBackendCacheConfig() *schema.Resource {
 		Update: transitSecretBackendCacheConfigUpdate,
 		Read:   transitSecretBackendCacheConfigRead,
 		Delete: transitSecretBackendCacheConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -80,6 +83,7 @@ func transitSecretBackendCacheConfigRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
+	d.Set("backend", strings.TrimSuffix(backend, "/cache-config"))
 	d.Set("size", secret.Data["size"])
 
 	return nil
@@ -0,0 +1,203 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	userpassAuthBackendUserBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/users/.+$")
+	userpassAuthBackendUserNameFromPathRegex    = regexp.MustCompile("^auth/.+/users/(.+)$")
+)
+
+func userpassAuthBackendUserResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"username": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			StateFunc: func(v interface{}) string {
+				return strings.ToLower(v.(string))
+			},
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password for the user. Only written to Vault on create, or when password_version changes.",
+		},
+		"password_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Increment this to trigger a password update on the next apply without changing the password argument itself.",
+		},
+		"backend": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "userpass",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: userpassAuthBackendUserCreate,
+		Update: userpassAuthBackendUserUpdate,
+		Read:   userpassAuthBackendUserRead,
+		Delete: userpassAuthBackendUserDelete,
+		Exists: userpassAuthBackendUserExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func userpassAuthBackendUserPath(backend, username string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/users/" + strings.ToLower(strings.Trim(username, "/"))
+}
+
+func userpassAuthBackendUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	username := d.Get("username").(string)
+	path := userpassAuthBackendUserPath(backend, username)
+
+	data := map[string]interface{}{}
+	updateTokenFields(d, data, true)
+
+	if v, ok := d.GetOk("password"); ok {
+		data["password"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing userpass user %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing userpass user %q: %s", path, err)
+	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote userpass user %q", path)
+
+	return userpassAuthBackendUserRead(d, meta)
+}
+
+func userpassAuthBackendUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	updateTokenFields(d, data, false)
+
+	// The password is never read back from Vault, so only send it when the
+	// user changed it or explicitly requested a rotation via password_version.
+	if d.HasChange("password") || d.HasChange("password_version") {
+		data["password"] = d.Get("password").(string)
+	}
+
+	log.Printf("[DEBUG] Updating userpass user %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated userpass user %q", path)
+
+	return userpassAuthBackendUserRead(d, meta)
+}
+
+func userpassAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := userpassAuthBackendUserBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for userpass user: %s", path, err)
+	}
+
+	username, err := userpassAuthBackendUserNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for userpass user: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading userpass user %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read userpass user %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] userpass user %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+
+	d.Set("backend", backend)
+	d.Set("username", username)
+
+	return nil
+}
+
+func userpassAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting userpass user %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted userpass user %q", path)
+
+	return nil
+}
+
+func userpassAuthBackendUserExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if userpass user %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if userpass user %q exists", path)
+
+	return resp != nil, nil
+}
+
+func userpassAuthBackendUserNameFromPath(path string) (string, error) {
+	if !userpassAuthBackendUserNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no user found")
+	}
+	res := userpassAuthBackendUserNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for user", len(res))
+	}
+	return res[1], nil
+}
+
+func userpassAuthBackendUserBackendFromPath(path string) (string, error) {
+	if !userpassAuthBackendUserBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := userpassAuthBackendUserBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccUserpassAuthBackendUser_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-userpass")
+	username := acctest.RandomWithPrefix("user")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccUserpassAuthBackendUserCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserpassAuthBackendUserConfig_basic(backend, username, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_userpass_auth_backend_user.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_userpass_auth_backend_user.test", "username", username),
+					resource.TestCheckResourceAttr("vault_userpass_auth_backend_user.test", "token_policies.0", "dev"),
+				),
+			},
+			{
+				Config: testAccUserpassAuthBackendUserConfig_basic(backend, username, "ops"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_userpass_auth_backend_user.test", "token_policies.0", "ops"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserpassAuthBackendUserCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_userpass_auth_backend_user" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("user %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccUserpassAuthBackendUserConfig_basic(backend, username, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  backend = "${vault_auth_backend.userpass.path}"
+  username = "%s"
+  password = "supersecret"
+  token_policies = ["%s"]
+}
+`, backend, username, policy)
+}
@@ -98,6 +98,12 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
+	if policy == nil {
+		log.Printf("[WARN] %s policy %q not found, removing from state", policyType, name)
+		d.SetId("")
+		return nil
+	}
+
 	for _, value := range attributes {
 		d.Set(value, policy[value])
 	}